@@ -0,0 +1,204 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/oracle/verify"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/jsonrpc/client"
+)
+
+var (
+	oracleRPCAddr       string
+	oracleCompareRemote string
+)
+
+// OracleCmd groups the commands controlling the oracle of a running node.
+// Pausing and resuming call the unsafe oracle RPC routes, which requires
+// rpc.unsafe to be enabled on the node.
+var OracleCmd = &cobra.Command{
+	Use:   "oracle",
+	Short: "Control the oracle of a running node",
+}
+
+var oraclePauseCmd = &cobra.Command{
+	Use:   "pause",
+	Short: "Stop signing new batches of oracle votes, while still relaying the votes of other validators",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return callOracleRoute("oracle_pause")
+	},
+}
+
+var oracleResumeCmd = &cobra.Command{
+	Use:   "resume",
+	Short: "Resume signing new batches of oracle votes",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return callOracleRoute("oracle_resume")
+	},
+}
+
+var oracleCompareCmd = &cobra.Command{
+	Use:   "compare",
+	Short: "Compare the oracle votes gossiped by the node with those gossiped by another node",
+	Long: `Compare the oracle votes gossiped by the node with those gossiped by another node,
+listing the validators with votes on only one of the nodes, the votes of a validator
+with different data on each node, and the validators whose latest batch was signed at
+a different time on each node.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		local, err := fetchGossipedVotes(oracleRPCAddr)
+		if err != nil {
+			return err
+		}
+		remote, err := fetchGossipedVotes(oracleCompareRemote)
+		if err != nil {
+			return err
+		}
+		fmt.Print(compareGossipedVotes(local, remote))
+		return nil
+	},
+}
+
+func init() {
+	OracleCmd.PersistentFlags().StringVar(
+		&oracleRPCAddr,
+		"rpc-laddr",
+		"tcp://localhost:26657",
+		"the CometBFT node's RPC address (<host>:<port>), credentials may be given as user:password@<host>:<port>",
+	)
+
+	oracleCompareCmd.Flags().StringVar(
+		&oracleCompareRemote,
+		"remote",
+		"",
+		"the RPC address (<host>:<port>) of the node to compare with",
+	)
+	_ = oracleCompareCmd.MarkFlagRequired("remote")
+
+	OracleCmd.AddCommand(oraclePauseCmd)
+	OracleCmd.AddCommand(oracleResumeCmd)
+	OracleCmd.AddCommand(oracleCompareCmd)
+}
+
+func callOracleRoute(method string) error {
+	client, err := rpcclient.New(oracleRPCAddr)
+	if err != nil {
+		return fmt.Errorf("failed to create RPC client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	result := new(ctypes.ResultOracleSigning)
+	if _, err := client.Call(ctx, method, map[string]interface{}{}, result); err != nil {
+		return fmt.Errorf("%s failed: %w", method, err)
+	}
+
+	if result.Paused {
+		fmt.Println("Oracle signing is paused")
+	} else {
+		fmt.Println("Oracle signing is running")
+	}
+	return nil
+}
+
+func fetchGossipedVotes(addr string) ([]*oracleproto.GossipedVotes, error) {
+	client, err := rpcclient.New(addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RPC client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	result := new(ctypes.ResultOracleGossipedVotes)
+	if _, err := client.Call(ctx, "oracle_gossiped_votes", map[string]interface{}{}, result); err != nil {
+		return nil, fmt.Errorf("oracle_gossiped_votes on %s failed: %w", addr, err)
+	}
+	return result.Batches, nil
+}
+
+// signerVotes are the votes gossiped by a node for a single signer, keyed by
+// oracle ID and timestamp, along with the time its latest batch was signed.
+type signerVotes struct {
+	latestSigned int64
+	votes        map[string]string
+}
+
+// groupGossipedVotes groups the votes of the batches by the address of their
+// signer. Batches with an invalid public key are skipped.
+func groupGossipedVotes(batches []*oracleproto.GossipedVotes) map[string]*signerVotes {
+	signers := make(map[string]*signerVotes)
+	for _, batch := range batches {
+		pubKey, err := verify.PubKey(batch)
+		if err != nil {
+			continue
+		}
+		addr := pubKey.Address().String()
+		signer, ok := signers[addr]
+		if !ok {
+			signer = &signerVotes{votes: make(map[string]string)}
+			signers[addr] = signer
+		}
+		if batch.SignedTimestamp > signer.latestSigned {
+			signer.latestSigned = batch.SignedTimestamp
+		}
+		for _, vote := range batch.Votes {
+			data := vote.Data
+			if data == "" && len(vote.DataHash) > 0 {
+				data = fmt.Sprintf("hash:%X", vote.DataHash)
+			}
+			signer.votes[fmt.Sprintf("%s@%d", vote.OracleId, vote.Timestamp)] = data
+		}
+	}
+	return signers
+}
+
+// compareGossipedVotes returns a report of the differences between the votes
+// gossiped by the local and the remote node. Votes gossiped by only one of
+// the nodes are not reported, as their gossip may not have completed yet.
+func compareGossipedVotes(local, remote []*oracleproto.GossipedVotes) string {
+	localSigners, remoteSigners := groupGossipedVotes(local), groupGossipedVotes(remote)
+
+	var missingLocal, missingRemote, divergent, skewed []string
+	for addr, l := range localSigners {
+		r, ok := remoteSigners[addr]
+		if !ok {
+			missingRemote = append(missingRemote, addr)
+			continue
+		}
+		if l.latestSigned != r.latestSigned {
+			skewed = append(skewed, fmt.Sprintf("%s: local %d, remote %d (%+ds)", addr, l.latestSigned, r.latestSigned, r.latestSigned-l.latestSigned))
+		}
+		for key, data := range l.votes {
+			if remoteData, ok := r.votes[key]; ok && remoteData != data {
+				divergent = append(divergent, fmt.Sprintf("%s %s: local %q, remote %q", addr, key, data, remoteData))
+			}
+		}
+	}
+	for addr := range remoteSigners {
+		if _, ok := localSigners[addr]; !ok {
+			missingLocal = append(missingLocal, addr)
+		}
+	}
+
+	var sb strings.Builder
+	writeSection := func(title string, lines []string) {
+		sort.Strings(lines)
+		fmt.Fprintf(&sb, "%s: %d\n", title, len(lines))
+		for _, line := range lines {
+			fmt.Fprintf(&sb, "  %s\n", line)
+		}
+	}
+	writeSection("Validators missing on the local node", missingLocal)
+	writeSection("Validators missing on the remote node", missingRemote)
+	writeSection("Votes with divergent data", divergent)
+	writeSection("Validators with skewed signed timestamps", skewed)
+	return sb.String()
+}
@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/oracle/verify"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+)
+
+func TestCompareGossipedVotes(t *testing.T) {
+	keys := []ed25519.PubKey{
+		ed25519.GenPrivKey().PubKey().(ed25519.PubKey),
+		ed25519.GenPrivKey().PubKey().(ed25519.PubKey),
+		ed25519.GenPrivKey().PubKey().(ed25519.PubKey),
+	}
+	batch := func(key ed25519.PubKey, signed int64, votes ...*oracleproto.Vote) *oracleproto.GossipedVotes {
+		return &oracleproto.GossipedVotes{
+			PubKey:          key,
+			SignedTimestamp: signed,
+			Signature:       []byte{verify.MainAccountSigPrefix, verify.Ed25519SignType},
+			Votes:           votes,
+		}
+	}
+
+	local := []*oracleproto.GossipedVotes{
+		batch(keys[0], 10, &oracleproto.Vote{OracleId: "a", Timestamp: 10, Data: "1"}, &oracleproto.Vote{OracleId: "b", Timestamp: 10, Data: "1"}),
+		batch(keys[1], 10, &oracleproto.Vote{OracleId: "a", Timestamp: 10, Data: "1"}),
+	}
+	remote := []*oracleproto.GossipedVotes{
+		batch(keys[0], 12, &oracleproto.Vote{OracleId: "a", Timestamp: 10, Data: "2"}),
+		batch(keys[2], 10, &oracleproto.Vote{OracleId: "a", Timestamp: 10, Data: "1"}),
+	}
+
+	assert.Equal(t, "Validators missing on the local node: 1\n"+
+		"  "+keys[2].Address().String()+"\n"+
+		"Validators missing on the remote node: 1\n"+
+		"  "+keys[1].Address().String()+"\n"+
+		"Votes with divergent data: 1\n"+
+		"  "+keys[0].Address().String()+" a@10: local \"1\", remote \"2\"\n"+
+		"Validators with skewed signed timestamps: 1\n"+
+		"  "+keys[0].Address().String()+": local 10, remote 12 (+2s)\n",
+		compareGossipedVotes(local, remote))
+}
@@ -0,0 +1,315 @@
+// oracle-bench spins up a number of in-process oracle reactors connected over
+// in-memory p2p connections, feeds them synthetic votes at a configurable rate
+// and reports vote throughput, convergence latency and memory use. It is meant
+// as a regression harness for changes to the oracle gossip protocol.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/oracle"
+	"github.com/cometbft/cometbft/oracle/oracletest"
+	"github.com/cometbft/cometbft/p2p"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+const benchChainID = "oracle-bench"
+
+func main() {
+	var (
+		numValidators  = flag.Int("validators", 4, "Number of in-process validators to connect")
+		rate           = flag.Float64("rate", 10, "Synthetic votes produced per second by each validator")
+		numOracles     = flag.Int("oracles", 10, "Number of distinct oracle IDs to produce votes for")
+		duration       = flag.Duration("duration", 30*time.Second, "How long to produce votes for")
+		settle         = flag.Duration("settle", 5*time.Second, "How long to wait for votes to converge after production stops")
+		sampleInterval = flag.Duration("sample-interval", 20*time.Millisecond, "How often to sample the gossip buffers of each validator")
+	)
+	flag.Parse()
+
+	if *numValidators < 2 || *rate <= 0 || *numOracles <= 0 {
+		fmt.Fprintln(os.Stderr, "validators must be at least 2, rate and oracles must be positive")
+		os.Exit(1)
+	}
+
+	logrus.SetLevel(logrus.ErrorLevel)
+
+	tracker := newVoteTracker(*numValidators)
+	reactors := makeReactors(*numValidators, time.Duration(float64(time.Second) / *rate), *numOracles, tracker)
+
+	switches := p2p.MakeConnectedSwitches(cfg.DefaultP2PConfig(), *numValidators, func(i int, sw *p2p.Switch) *p2p.Switch {
+		sw.AddReactor("ORACLE", reactors[i])
+		sw.AddReactor("PEERSTATE", oracletest.NewPeerStateReactor())
+		return sw
+	}, p2p.Connect2Switches)
+	defer func() {
+		for _, sw := range switches {
+			if err := sw.Stop(); err != nil {
+				fmt.Fprintf(os.Stderr, "error stopping switch: %v\n", err)
+			}
+		}
+	}()
+
+	var memStart runtime.MemStats
+	runtime.ReadMemStats(&memStart)
+
+	fmt.Printf("Producing votes from %d validators at %v votes/s each for %v\n", *numValidators, *rate, *duration)
+	tracker.Start(*duration)
+
+	ticker := time.NewTicker(*sampleInterval)
+	defer ticker.Stop()
+	end := time.After(*duration + *settle)
+sample:
+	for {
+		select {
+		case <-ticker.C:
+			for i, r := range reactors {
+				tracker.Observe(i, r.OracleInfo.State.CurrentBatches())
+			}
+		case <-end:
+			break sample
+		}
+	}
+
+	var memEnd runtime.MemStats
+	runtime.ReadMemStats(&memEnd)
+
+	tracker.Report(*duration, &memStart, &memEnd)
+}
+
+// makeReactors creates one oracle reactor per validator, each fed synthetic
+// votes by its own benchApp.
+func makeReactors(n int, interval time.Duration, numOracles int, tracker *voteTracker) []*oracle.Reactor {
+	privVals := make([]types.PrivValidator, n)
+	validators := make([]*types.Validator, n)
+	for i := 0; i < n; i++ {
+		privVals[i] = types.NewMockPV()
+		pubKey, err := privVals[i].GetPubKey()
+		if err != nil {
+			panic(err)
+		}
+		validators[i] = types.NewValidator(pubKey, 10)
+	}
+	valSet := types.NewValidatorSet(validators)
+	consensusState := &benchConsensusState{
+		state: sm.State{
+			ChainID:         benchChainID,
+			LastBlockHeight: 1,
+			LastBlockTime:   time.Now(),
+			Validators:      valSet,
+			NextValidators:  valSet,
+			LastValidators:  valSet,
+		},
+	}
+
+	reactors := make([]*oracle.Reactor, n)
+	for i := 0; i < n; i++ {
+		pubKey, err := privVals[i].GetPubKey()
+		if err != nil {
+			panic(err)
+		}
+		app := &benchApp{
+			validator:  pubKey.Address().String(),
+			interval:   interval,
+			numOracles: numOracles,
+			tracker:    tracker,
+		}
+		reactors[i] = oracle.NewReactor(cfg.DefaultOracleConfig(), pubKey, privVals[i], app, nil)
+		reactors[i].ConsensusState = consensusState
+	}
+	return reactors
+}
+
+//-----------------------------------------------------------------------------
+
+// benchConsensusState is a static consensus state with a single validator set.
+type benchConsensusState struct {
+	state sm.State
+}
+
+func (cs *benchConsensusState) GetState() sm.State   { return cs.state }
+func (cs *benchConsensusState) GetLastHeight() int64 { return cs.state.LastBlockHeight }
+func (cs *benchConsensusState) GetValidators() (int64, []*types.Validator) {
+	return cs.state.LastBlockHeight, cs.state.Validators.Validators
+}
+
+// benchApp serves synthetic votes to the oracle runner. Only the methods used
+// by the oracle are implemented.
+type benchApp struct {
+	proxy.AppConnConsensus
+
+	validator  string
+	interval   time.Duration
+	numOracles int
+	tracker    *voteTracker
+
+	seq int
+}
+
+func (app *benchApp) FetchOracleVotes(context.Context, *abci.RequestFetchOracleVotes) (*abci.ResponseFetchOracleVotes, error) {
+	app.tracker.WaitStarted()
+	time.Sleep(app.interval)
+	if !app.tracker.Producing() {
+		return &abci.ResponseFetchOracleVotes{}, nil
+	}
+
+	app.seq++
+	vote := &abci.ResponseFetchOracleVotes{}
+	vote.Vote = newBenchVote(app.validator, app.seq, app.numOracles)
+	app.tracker.Produced(app.validator, vote.Vote.Data)
+	return vote, nil
+}
+
+func (app *benchApp) DoesOracleResultExist(context.Context, *abci.RequestDoesOracleResultExist) (*abci.ResponseDoesOracleResultExist, error) {
+	return &abci.ResponseDoesOracleResultExist{DoesExist: false}, nil
+}
+
+func (app *benchApp) DoesSubAccountBelongToVal(context.Context, *abci.RequestDoesSubAccountBelongToVal) (*abci.ResponseDoesSubAccountBelongToVal, error) {
+	return &abci.ResponseDoesSubAccountBelongToVal{BelongsToVal: false}, nil
+}
+
+//-----------------------------------------------------------------------------
+
+type voteKey struct {
+	validator string
+	data      string
+}
+
+type voteStatus struct {
+	producedAt time.Time
+	seenBy     []bool
+	seenCount  int
+	latency    time.Duration
+}
+
+// voteTracker records when each synthetic vote was produced and when it was
+// first observed in the gossip buffer of each validator.
+type voteTracker struct {
+	mtx           cmtsync.Mutex
+	numValidators int
+	started       chan struct{}
+	endTime       time.Time
+	votes         map[voteKey]*voteStatus
+	latencies     []time.Duration
+}
+
+func newVoteTracker(numValidators int) *voteTracker {
+	return &voteTracker{
+		numValidators: numValidators,
+		started:       make(chan struct{}),
+		votes:         make(map[voteKey]*voteStatus),
+	}
+}
+
+func (t *voteTracker) Start(duration time.Duration) {
+	t.mtx.Lock()
+	t.endTime = time.Now().Add(duration)
+	t.mtx.Unlock()
+	close(t.started)
+}
+
+func (t *voteTracker) WaitStarted() {
+	<-t.started
+}
+
+func (t *voteTracker) Producing() bool {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	return time.Now().Before(t.endTime)
+}
+
+func (t *voteTracker) Produced(validator, data string) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	t.votes[voteKey{validator, data}] = &voteStatus{
+		producedAt: time.Now(),
+		seenBy:     make([]bool, t.numValidators),
+	}
+}
+
+// Observe records the votes in the gossip buffer of the i'th validator.
+func (t *voteTracker) Observe(i int, batches []*oracleproto.GossipedVotes) {
+	now := time.Now()
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	for _, batch := range batches {
+		for _, vote := range batch.Votes {
+			status, ok := t.votes[voteKey{vote.Validator, vote.Data}]
+			if !ok || status.seenBy[i] {
+				continue
+			}
+			status.seenBy[i] = true
+			status.seenCount++
+			if status.seenCount == t.numValidators {
+				status.latency = now.Sub(status.producedAt)
+				t.latencies = append(t.latencies, status.latency)
+			}
+		}
+	}
+}
+
+func (t *voteTracker) Report(duration time.Duration, memStart, memEnd *runtime.MemStats) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	produced := len(t.votes)
+	converged := len(t.latencies)
+	sort.Slice(t.latencies, func(i, j int) bool { return t.latencies[i] < t.latencies[j] })
+
+	fmt.Printf("Votes produced:     %d (%.1f votes/s)\n", produced, float64(produced)/duration.Seconds())
+	fmt.Printf("Votes converged:    %d (%.1f%%)\n", converged, percent(converged, produced))
+	fmt.Printf("Throughput:         %.1f converged votes/s\n", float64(converged)/duration.Seconds())
+	if converged > 0 {
+		fmt.Printf("Convergence p50:    %v\n", t.percentile(0.50))
+		fmt.Printf("Convergence p90:    %v\n", t.percentile(0.90))
+		fmt.Printf("Convergence p99:    %v\n", t.percentile(0.99))
+		fmt.Printf("Convergence max:    %v\n", t.latencies[converged-1])
+	}
+	fmt.Printf("Heap in use:        %s\n", formatBytes(memEnd.HeapInuse))
+	fmt.Printf("Total allocated:    %s\n", formatBytes(memEnd.TotalAlloc-memStart.TotalAlloc))
+	fmt.Printf("GC cycles:          %d\n", memEnd.NumGC-memStart.NumGC)
+}
+
+// percentile assumes the latencies are sorted and non-empty.
+func (t *voteTracker) percentile(p float64) time.Duration {
+	idx := int(float64(len(t.latencies)-1) * p)
+	return t.latencies[idx]
+}
+
+func newBenchVote(validator string, seq int, numOracles int) *oracleproto.Vote {
+	return &oracleproto.Vote{
+		Validator: validator,
+		OracleId:  "oracle-" + strconv.Itoa(seq%numOracles),
+		Timestamp: time.Now().Unix(),
+		Data:      strconv.Itoa(seq),
+	}
+}
+
+func percent(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) * 100 / float64(total)
+}
+
+func formatBytes(b uint64) string {
+	return fmt.Sprintf("%.1f MiB", float64(b)/(1<<20))
+}
@@ -860,26 +860,128 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 
 // OracleConfig defines the configuration for the CometBFT oracle service
 type OracleConfig struct {
+	// Enable runs the oracle reactor. When disabled the node neither fetches,
+	// signs nor gossips votes, and no oracle tx is injected into proposals
+	Enable bool `mapstructure:"enable"`
+	// RelayOnly only verifies and forwards gossiped votes, without fetching or signing votes
+	// of its own, for sentry nodes
+	RelayOnly bool `mapstructure:"relay_only"`
 	// MaxOracleGossipBlocksDelayed determines how long we should keep the gossip votes in terms of block height
 	MaxOracleGossipBlocksDelayed int `mapstructure:"max_oracle_gossip_blocks_delayed"`
 	// MaxOracleGossipAge determines how long we should keep the gossip votes in terms of seconds
 	MaxOracleGossipAge int `mapstructure:"max_oracle_gossip_age"`
+	// MaxOracleRelayAge determines for how long, in seconds, we relay the gossip votes of other validators,
+	// independently of how long they are kept. Zero relays them for as long as they are kept
+	MaxOracleRelayAge int `mapstructure:"max_oracle_relay_age"`
 	// Interval determines how long we should wait before batch signing votes
 	SignInterval time.Duration `mapstructure:"sign_interval"`
+	// Period after the oracle starts during which votes are fetched from the app, e.g. so that
+	// its caches fill up, but dropped rather than signed and gossiped. Zero signs votes right away
+	WarmUpPeriod time.Duration `mapstructure:"warm_up_period"`
 	// Interval determines how long we should wait between gossiping of votes
 	GossipInterval time.Duration `mapstructure:"gossip_interval"`
+	// Number of random peers votes are gossiped to every gossip interval, in addition to
+	// GossipPriorityPeerIDs. Zero gossips votes to every peer
+	GossipFanout int `mapstructure:"gossip_fanout"`
+	// Comma separated list of the node IDs of peers votes are always gossiped to, such as validators
+	GossipPriorityPeerIDs string `mapstructure:"gossip_priority_peer_ids"`
+	// Time without a new block after which consensus is considered stressed, e.g. by rounds timing out,
+	// and votes are gossiped every ConsensusStressGossipInterval to leave bandwidth to consensus. Zero disables it
+	ConsensusStressThreshold time.Duration `mapstructure:"consensus_stress_threshold"`
+	// Interval between gossiping of votes while consensus is stressed, instead of as soon as they are signed
+	ConsensusStressGossipInterval time.Duration `mapstructure:"consensus_stress_gossip_interval"`
+	// Timeout of a single call to the app or feeder to fetch a vote to sign. Zero does not time out,
+	// for apps which hold the call until a vote is ready
+	FetchTimeout time.Duration `mapstructure:"fetch_timeout"`
+	// Number of fetched votes that can wait to be signed
+	SignQueueCapacity int `mapstructure:"sign_queue_capacity"`
+	// Number of fetched votes waiting to be signed at which votes stop being fetched, until half of
+	// them are signed, so that votes are not fetched only to go stale in the queue. It must be lower
+	// than SignQueueCapacity. Zero fetches votes as long as there is room in the queue
+	SignQueueHighWatermark int `mapstructure:"sign_queue_high_watermark"`
+	// Timeout of the queries made to the app by the oracle, such as whether a vote already has a
+	// result or whether a sub account belongs to a validator. Zero does not time out
+	AppQueryTimeout time.Duration `mapstructure:"app_query_timeout"`
 	// Interval determines how long we should wait between trying to prune
 	PruneInterval time.Duration `mapstructure:"prune_interval"`
-	// Max allowable size for votes that can be gossiped from peer to peer
+	// Interval between signed heartbeats published while there are no votes to sign,
+	// so peers can tell a validator with nothing to vote on from one that is offline. Zero disables heartbeats
+	HeartbeatInterval time.Duration `mapstructure:"heartbeat_interval"`
+	// Interval between pings sent to each peer on the oracle channels, to measure the round trip
+	// time of the oracle mesh. Zero disables pings
+	PingInterval time.Duration `mapstructure:"ping_interval"`
+	// Interval between probes signed by the node key and relayed across the oracle mesh, to measure
+	// how long messages take to propagate from each node. Zero disables sending probes, the probes
+	// of other nodes are still relayed
+	ProbeInterval time.Duration `mapstructure:"probe_interval"`
+	// Max allowable size for votes that can be gossiped from peer to peer,
+	// also the receive message capacity of the oracle channels carrying batches of votes
 	MaxGossipMsgSize int `mapstructure:"max_gossip_msg_size"`
+	// Priority of the oracle channel relative to the other p2p channels
+	ChannelPriority int `mapstructure:"channel_priority"`
+	// Number of batches of votes that can be queued for sending to a peer on the oracle channel
+	ChannelSendQueueCapacity int `mapstructure:"channel_send_queue_capacity"`
+	// Max total size of the gossiped batches of votes kept in memory, beyond which the
+	// oldest batches of other validators are evicted. Zero does not bound the batches
+	MaxGossipBufferBytes int `mapstructure:"max_gossip_buffer_bytes"`
+	// Budget of the memory taken by the gossiped batches of votes and the data of votes gossiped by
+	// data hash, split between them in proportion, for nodes with little memory. Zero only bounds the
+	// batches, by MaxGossipBufferBytes
+	MaxMemoryBytes int `mapstructure:"max_memory_bytes"`
+	// Max number of votes of a single oracle ID waiting to be signed, further votes fetched for it are
+	// dropped until its votes are pruned. Zero does not bound the votes
+	MaxUnsignedVotesPerOracle int `mapstructure:"max_unsigned_votes_per_oracle"`
+	// Max number of votes in a single signed batch, votes beyond this are signed in follow-up batches
+	MaxVotesPerBatch int `mapstructure:"max_votes_per_batch"`
+	// Max size of vote data gossiped inline, larger data is replaced by its hash and fetched
+	// by peers on demand. Zero always gossips data inline
+	MaxInlineDataSize int `mapstructure:"max_inline_data_size"`
+	// Receive message capacity of the oracle data channel, which carries the data of votes gossiped by
+	// data hash. Votes with data too large to fit in a single message are not signed
+	MaxDataMsgSize int `mapstructure:"max_data_msg_size"`
+	// Number of times a peer can misbehave on the oracle channels, e.g. by relaying batches of votes
+	// with an invalid signature, before we disconnect from it. Zero never disconnects peers for it
+	MaxPeerMisbehavior int `mapstructure:"max_peer_misbehavior"`
+	// Appends this node to the unsigned relay hops of the batches of votes it relays,
+	// for diagnosing propagation paths
+	RecordRelayHops bool `mapstructure:"record_relay_hops"`
+	// Informational moniker set on the batches of votes signed by this node, so that
+	// dashboards can show human readable signer names. It is not signed. Empty sets no moniker
+	SignerMoniker string `mapstructure:"signer_moniker"`
+	// Informational description of the operator set on the batches of votes signed by
+	// this node, e.g. an organization or contact. It is not signed. Empty sets no operator
+	SignerOperator string `mapstructure:"signer_operator"`
 	// Enables sub account signing for votes
 	EnableSubAccountSigning bool `mapstructure:"enable_sub_account_signing"`
 	// Path to the JSON file containing the subaccount key to use to sign oracle votes
 	SubAccountKeyFilePath string `mapstructure:"sub_account_key_file_path"`
+	// gRPC address of a separate feeder process to fetch the votes to sign from, instead of the app,
+	// so that the validator does not reach out to data providers itself. Empty fetches votes from the app
+	FeederAddress string `mapstructure:"feeder_address"`
+	// Route prefixed to signed vote batches submitted as mempool transactions,
+	// for networks where not every node runs the oracle reactor yet. Empty disables the fallback
+	TxFallbackRoute string `mapstructure:"tx_fallback_route"`
 }
 
 const (
 	DefaultOracleSubAccountKeyName = "oracle_sub_account_key.json"
+
+	// bounds on the oracle channel tuning, the send queue holds up to
+	// max_gossip_msg_size bytes per batch for every peer
+	maxOracleChannelPriority          = 100
+	maxOracleChannelSendQueueCapacity = 1000
+
+	// bound on the informational signer metadata, which is sent along every batch
+	maxOracleSignerMetadataLength = 128
+
+	// size of a data hash in an encoded request on the oracle data channel, and bound on the
+	// size of the data hash and of the encoding of a response beyond its data
+	oracleDataHashEncodedSize  = 34
+	oracleDataResponseOverhead = 64
+
+	// share of max_memory_bytes, in percent, taken by the gossiped batches of votes,
+	// the rest is taken by the data of votes gossiped by data hash
+	oracleGossipBufferMemoryShare = 80
 )
 
 var (
@@ -889,14 +991,43 @@ var (
 // DefaultOracleConfig returns a default configuration for the CometBFT oracle service
 func DefaultOracleConfig() *OracleConfig {
 	return &OracleConfig{
-		MaxOracleGossipBlocksDelayed: 3,                              // keep all gossipVotes from at most 3 blocks behind
-		MaxOracleGossipAge:           20,                             // keep all gossipVotes from at most 20s ago
-		SignInterval:                 100 * time.Millisecond,         // 0.1s
-		GossipInterval:               250 * time.Millisecond,         // 0.25s
-		PruneInterval:                500 * time.Millisecond,         // 0.5s
-		MaxGossipMsgSize:             65536,                          // only allow p2p of votes of max size 65536 bytes
-		EnableSubAccountSigning:      false,                          // default to false
-		SubAccountKeyFilePath:        defaultOracleSubAccountKeyPath, // default file path to subaccount key (config/oracle_sub_account_key.json)
+		Enable:                        true,                           // run the oracle reactor
+		RelayOnly:                     false,                          // default to fetching and signing votes
+		MaxOracleGossipBlocksDelayed:  3,                              // keep all gossipVotes from at most 3 blocks behind
+		MaxOracleGossipAge:            20,                             // keep all gossipVotes from at most 20s ago
+		MaxOracleRelayAge:             0,                              // default to relaying gossipVotes for as long as they are kept
+		SignInterval:                  100 * time.Millisecond,         // 0.1s
+		WarmUpPeriod:                  0,                              // default to signing votes right away
+		GossipInterval:                250 * time.Millisecond,         // 0.25s
+		GossipFanout:                  0,                              // default to gossiping to every peer
+		GossipPriorityPeerIDs:         "",                             // no peers are always gossiped to
+		ConsensusStressThreshold:      0,                              // default to gossiping at the same pace under consensus stress
+		ConsensusStressGossipInterval: 2 * time.Second,                // 2s
+		FetchTimeout:                  0,                              // default to waiting for the app to return a vote
+		SignQueueCapacity:             1024,                           // queue at most 1024 votes waiting to be signed
+		SignQueueHighWatermark:        768,                            // stop fetching votes with 768 votes waiting to be signed
+		AppQueryTimeout:               5 * time.Second,                // 5s
+		PruneInterval:                 500 * time.Millisecond,         // 0.5s
+		HeartbeatInterval:             0,                              // default to not publishing heartbeats
+		PingInterval:                  10 * time.Second,               // 10s
+		ProbeInterval:                 30 * time.Second,               // 30s
+		MaxGossipMsgSize:              65536,                          // only allow p2p of votes of max size 65536 bytes
+		ChannelPriority:               5,                              // same priority as the mempool channel
+		ChannelSendQueueCapacity:      10,                             // queue at most 10 batches per peer
+		MaxGossipBufferBytes:          104857600,                      // keep at most 100 MiB of gossiped batches
+		MaxMemoryBytes:                0,                              // default to only bounding the gossiped batches
+		MaxUnsignedVotesPerOracle:     1000,                           // keep at most 1000 votes per oracle waiting to be signed
+		MaxVotesPerBatch:              500,                            // sign at most 500 votes per batch
+		MaxInlineDataSize:             0,                              // default to always gossiping data inline
+		MaxDataMsgSize:                1048576,                        // only allow p2p of vote data of max size 1 MiB
+		MaxPeerMisbehavior:            10,                             // disconnect from peers after misbehaving 10 times
+		RecordRelayHops:               false,                          // default to relaying batches as they are received
+		SignerMoniker:                 "",                             // default to not describing the signer
+		SignerOperator:                "",                             // default to not describing the operator
+		EnableSubAccountSigning:       false,                          // default to false
+		SubAccountKeyFilePath:         defaultOracleSubAccountKeyPath, // default file path to subaccount key (config/oracle_sub_account_key.json)
+		FeederAddress:                 "",                             // default to fetching votes from the app
+		TxFallbackRoute:               "",                             // default to only gossiping votes over the oracle channel
 	}
 }
 
@@ -912,6 +1043,38 @@ func (cfg *OracleConfig) SubAccountKeyFile(rootDir string) string {
 	return rootify(cfg.SubAccountKeyFilePath, rootDir)
 }
 
+// GossipBufferBytes returns the max total size of the gossiped batches of votes kept in memory,
+// the smaller of MaxGossipBufferBytes and their share of MaxMemoryBytes. Zero does not bound them
+func (cfg *OracleConfig) GossipBufferBytes() int {
+	if cfg.MaxMemoryBytes == 0 {
+		return cfg.MaxGossipBufferBytes
+	}
+	share := cfg.MaxMemoryBytes / 100 * oracleGossipBufferMemoryShare
+	if cfg.MaxGossipBufferBytes > 0 && cfg.MaxGossipBufferBytes < share {
+		return cfg.MaxGossipBufferBytes
+	}
+	return share
+}
+
+// VoteDataBytes returns the max total size of the data of votes gossiped by data hash kept in
+// memory, their share of MaxMemoryBytes. Zero does not bound it
+func (cfg *OracleConfig) VoteDataBytes() int {
+	if cfg.MaxMemoryBytes == 0 {
+		return 0
+	}
+	return cfg.MaxMemoryBytes - cfg.MaxMemoryBytes/100*oracleGossipBufferMemoryShare
+}
+
+// MaxVoteDataSize returns the max size of the data of a vote gossiped by data hash, which fits in a
+// single message on the oracle data channel and in the share of MaxMemoryBytes taken by vote data
+func (cfg *OracleConfig) MaxVoteDataSize() int {
+	size := cfg.MaxDataMsgSize - oracleDataResponseOverhead
+	if dataBytes := cfg.VoteDataBytes(); dataBytes > 0 && dataBytes < size {
+		return dataBytes
+	}
+	return size
+}
+
 // ValidateBasic performs basic validation and returns an error if any check fails.
 func (cfg *OracleConfig) ValidateBasic() error {
 	if cfg.MaxOracleGossipBlocksDelayed <= 0 {
@@ -920,18 +1083,101 @@ func (cfg *OracleConfig) ValidateBasic() error {
 	if cfg.MaxOracleGossipAge <= 0 {
 		return errors.New("max_oracle_gossip_age must be positive")
 	}
+	if cfg.MaxOracleRelayAge < 0 {
+		return errors.New("max_oracle_relay_age can't be negative")
+	}
 	if cfg.SignInterval <= 0 {
 		return errors.New("sign_interval must be positive")
 	}
+	if cfg.WarmUpPeriod < 0 {
+		return errors.New("warm_up_period can't be negative")
+	}
 	if cfg.GossipInterval <= 0 {
 		return errors.New("gossip_interval must be positive")
 	}
+	if cfg.GossipFanout < 0 {
+		return errors.New("gossip_fanout can't be negative")
+	}
+	if cfg.ConsensusStressThreshold < 0 {
+		return errors.New("consensus_stress_threshold can't be negative")
+	}
+	if cfg.ConsensusStressGossipInterval <= 0 {
+		return errors.New("consensus_stress_gossip_interval must be positive")
+	}
+	if cfg.FetchTimeout < 0 {
+		return errors.New("fetch_timeout can't be negative")
+	}
+	if cfg.SignQueueCapacity <= 0 {
+		return errors.New("sign_queue_capacity must be positive")
+	}
+	if cfg.SignQueueHighWatermark < 0 {
+		return errors.New("sign_queue_high_watermark can't be negative")
+	}
+	if cfg.SignQueueHighWatermark >= cfg.SignQueueCapacity {
+		return errors.New("sign_queue_high_watermark must be lower than sign_queue_capacity")
+	}
+	if cfg.AppQueryTimeout < 0 {
+		return errors.New("app_query_timeout can't be negative")
+	}
 	if cfg.PruneInterval <= 0 {
 		return errors.New("prune_interval must be positive")
 	}
+	if cfg.HeartbeatInterval < 0 {
+		return errors.New("heartbeat_interval can't be negative")
+	}
+	if cfg.PingInterval < 0 {
+		return errors.New("ping_interval can't be negative")
+	}
+	if cfg.ProbeInterval < 0 {
+		return errors.New("probe_interval can't be negative")
+	}
 	if cfg.MaxGossipMsgSize <= 0 {
 		return errors.New("max_gossip_msg_size must be positive")
 	}
+	if cfg.ChannelPriority <= 0 || cfg.ChannelPriority > maxOracleChannelPriority {
+		return fmt.Errorf("channel_priority must be between 1 and %d", maxOracleChannelPriority)
+	}
+	if cfg.ChannelSendQueueCapacity <= 0 || cfg.ChannelSendQueueCapacity > maxOracleChannelSendQueueCapacity {
+		return fmt.Errorf("channel_send_queue_capacity must be between 1 and %d", maxOracleChannelSendQueueCapacity)
+	}
+	if cfg.MaxGossipBufferBytes < 0 {
+		return errors.New("max_gossip_buffer_bytes can't be negative")
+	}
+	if cfg.MaxMemoryBytes < 0 {
+		return errors.New("max_memory_bytes can't be negative")
+	}
+	if cfg.MaxMemoryBytes > 0 && cfg.VoteDataBytes() < cfg.MaxGossipMsgSize {
+		return fmt.Errorf("max_memory_bytes must be at least %d, to hold a batch of max_gossip_msg_size and its data",
+			cfg.MaxGossipMsgSize*100/(100-oracleGossipBufferMemoryShare))
+	}
+	if cfg.MaxUnsignedVotesPerOracle < 0 {
+		return errors.New("max_unsigned_votes_per_oracle can't be negative")
+	}
+	if cfg.MaxVotesPerBatch <= 0 {
+		return errors.New("max_votes_per_batch must be positive")
+	}
+	if cfg.MaxInlineDataSize < 0 {
+		return errors.New("max_inline_data_size can't be negative")
+	}
+	if cfg.MaxDataMsgSize <= 0 {
+		return errors.New("max_data_msg_size must be positive")
+	}
+	if cfg.MaxDataMsgSize < cfg.MaxVotesPerBatch*oracleDataHashEncodedSize+oracleDataResponseOverhead {
+		return fmt.Errorf("max_data_msg_size must be at least %d, to hold a request for the data of max_votes_per_batch votes",
+			cfg.MaxVotesPerBatch*oracleDataHashEncodedSize+oracleDataResponseOverhead)
+	}
+	if cfg.MaxVoteDataSize() <= cfg.MaxInlineDataSize {
+		return errors.New("max_data_msg_size and max_memory_bytes must leave room for the data of votes larger than max_inline_data_size")
+	}
+	if cfg.MaxPeerMisbehavior < 0 {
+		return errors.New("max_peer_misbehavior can't be negative")
+	}
+	if len(cfg.SignerMoniker) > maxOracleSignerMetadataLength {
+		return fmt.Errorf("signer_moniker can't be longer than %d bytes", maxOracleSignerMetadataLength)
+	}
+	if len(cfg.SignerOperator) > maxOracleSignerMetadataLength {
+		return fmt.Errorf("signer_operator can't be longer than %d bytes", maxOracleSignerMetadataLength)
+	}
 	return nil
 }
 
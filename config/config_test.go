@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -134,6 +135,98 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 	assert.Error(t, cfg.ValidateBasic())
 }
 
+func TestOracleConfigValidateBasic(t *testing.T) {
+	cfg := config.TestOracleConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	fieldsToTest := []string{
+		"MaxOracleGossipBlocksDelayed",
+		"MaxOracleGossipAge",
+		"MaxOracleRelayAge",
+		"MaxGossipMsgSize",
+		"ChannelPriority",
+		"ChannelSendQueueCapacity",
+		"MaxVotesPerBatch",
+		"MaxUnsignedVotesPerOracle",
+		"MaxInlineDataSize",
+		"MaxDataMsgSize",
+		"MaxPeerMisbehavior",
+		"HeartbeatInterval",
+		"PingInterval",
+		"ProbeInterval",
+		"MaxGossipBufferBytes",
+		"MaxMemoryBytes",
+		"GossipFanout",
+		"WarmUpPeriod",
+		"FetchTimeout",
+		"SignQueueCapacity",
+		"SignQueueHighWatermark",
+		"AppQueryTimeout",
+		"ConsensusStressThreshold",
+		"ConsensusStressGossipInterval",
+	}
+
+	for _, fieldName := range fieldsToTest {
+		field := reflect.ValueOf(cfg).Elem().FieldByName(fieldName)
+		value := field.Int()
+		field.SetInt(-1)
+		assert.Error(t, cfg.ValidateBasic(), fieldName)
+		field.SetInt(value)
+	}
+
+	cfg.ChannelPriority = 101
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.ChannelPriority = 5
+
+	cfg.SignerMoniker = strings.Repeat("m", 129)
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.SignerMoniker = ""
+
+	cfg.ChannelSendQueueCapacity = 1001
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.ChannelSendQueueCapacity = 10
+
+	// votes would never stop being fetched
+	cfg.SignQueueHighWatermark = cfg.SignQueueCapacity
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.SignQueueHighWatermark = 768
+
+	// the data channel is too small for votes gossiped by data hash
+	cfg.MaxInlineDataSize = cfg.MaxDataMsgSize
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.MaxInlineDataSize = 0
+
+	// or for a request for the data of a full batch
+	cfg.MaxDataMsgSize = 1000
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.MaxDataMsgSize = 1048576
+
+	// the memory budget is too small for a single batch and its data
+	cfg.MaxMemoryBytes = 4000
+	assert.Error(t, cfg.ValidateBasic())
+}
+
+func TestOracleConfigMemoryBudget(t *testing.T) {
+	cfg := config.TestOracleConfig()
+	assert.Equal(t, cfg.MaxGossipBufferBytes, cfg.GossipBufferBytes())
+	assert.Zero(t, cfg.VoteDataBytes())
+
+	cfg.MaxMemoryBytes = 1000000
+	assert.Equal(t, 800000, cfg.GossipBufferBytes())
+	assert.Equal(t, 200000, cfg.VoteDataBytes())
+
+	// the gossiped batches are bounded by the smaller of the two
+	cfg.MaxGossipBufferBytes = 1000
+	assert.Equal(t, 1000, cfg.GossipBufferBytes())
+	cfg.MaxGossipBufferBytes = 0
+	assert.Equal(t, 800000, cfg.GossipBufferBytes())
+
+	// the data of a single vote fits in the share of the vote data
+	assert.Equal(t, 200000, cfg.MaxVoteDataSize())
+	cfg.MaxMemoryBytes = 0
+	assert.Equal(t, cfg.MaxDataMsgSize-64, cfg.MaxVoteDataSize())
+}
+
 func TestStateSyncConfigValidateBasic(t *testing.T) {
 	cfg := config.TestStateSyncConfig()
 	require.NoError(t, cfg.ValidateBasic())
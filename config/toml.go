@@ -425,30 +425,166 @@ experimental_max_gossip_connections_to_non_persistent_peers = {{ .Mempool.Experi
 #######################################################
 [oraclesvc]
 
+# Enable runs the oracle reactor. When disabled the node neither fetches, signs nor gossips
+# votes, and no oracle tx is injected into proposals
+enable = {{ .Oracle.Enable }}
+
+# RelayOnly only verifies and forwards gossiped votes, without fetching or signing votes of its own.
+# Meant for sentry nodes, which then don't need the oracle to be set up in the application
+relay_only = {{ .Oracle.RelayOnly }}
+
 # MaxOracleGossipBlocksDelayed determines how long we should keep the gossip votes in terms of block height
 max_oracle_gossip_blocks_delayed = "{{ .Oracle.MaxOracleGossipBlocksDelayed }}" 
 
 # MaxOracleGossipAge determines how long we should keep the gossip votes in terms of seconds
 max_oracle_gossip_age = "{{ .Oracle.MaxOracleGossipAge }}" 
 
+# MaxOracleRelayAge determines for how long, in seconds, we relay the gossip votes of other validators,
+# independently of how long they are kept, so that stale batches are not relayed again, e.g. after
+# a network partition heals. Our own votes are gossiped for as long as they are kept.
+# 0 relays the gossip votes of other validators for as long as they are kept
+max_oracle_relay_age = "{{ .Oracle.MaxOracleRelayAge }}"
+
 # Interval determines how long we should wait before batch signing votes
 sign_interval = "{{ .Oracle.SignInterval }}"
 
+# Period after the oracle starts during which votes are still fetched from the application, so
+# that its adapters and caches warm up, but are dropped rather than signed and gossiped, to avoid
+# publishing samples based on partial data. 0 signs votes right away
+warm_up_period = "{{ .Oracle.WarmUpPeriod }}"
+
 # Interval determines how long we should wait between gossiping of votes
 gossip_interval = "{{ .Oracle.GossipInterval }}"
 
+# Number of random peers votes are gossiped to every gossip interval, in addition to the
+# priority peers. Lower values trade propagation latency for bandwidth in large networks.
+# 0 gossips votes to every peer
+gossip_fanout = {{ .Oracle.GossipFanout }}
+
+# Comma separated list of the node IDs of peers votes are always gossiped to, such as the
+# validators among the peers, regardless of gossip_fanout
+gossip_priority_peer_ids = "{{ .Oracle.GossipPriorityPeerIDs }}"
+
+# Time without a new block after which consensus is considered stressed, e.g. by rounds timing
+# out. Votes are then gossiped every consensus_stress_gossip_interval instead of as soon as they
+# are signed, to leave bandwidth to consensus, until blocks are committed again. 0 disables it
+consensus_stress_threshold = "{{ .Oracle.ConsensusStressThreshold }}"
+consensus_stress_gossip_interval = "{{ .Oracle.ConsensusStressGossipInterval }}"
+
+# Timeout of a single call to the application, or feeder, to fetch a vote to sign. Calls are
+# also canceled when the node stops. 0 does not time out, for applications which hold the call
+# until a vote is ready
+fetch_timeout = "{{ .Oracle.FetchTimeout }}"
+
+# Number of fetched votes that can wait to be signed
+sign_queue_capacity = {{ .Oracle.SignQueueCapacity }}
+
+# Number of fetched votes waiting to be signed at which votes stop being fetched from the
+# application, until half of them are signed, so that votes are not fetched only to go stale in the
+# queue. It must be lower than sign_queue_capacity. 0 fetches votes as long as there is room in the
+# queue
+sign_queue_high_watermark = {{ .Oracle.SignQueueHighWatermark }}
+
+# Timeout of the queries made to the application by the oracle, such as whether a vote already
+# has a result or whether a sub account belongs to a validator. 0 does not time out
+app_query_timeout = "{{ .Oracle.AppQueryTimeout }}"
+
 # Interval determines how long we should wait between trying to prune
 prune_interval = "{{ .Oracle.PruneInterval }}"
 
-# Max allowable size for votes that can be gossiped from peer to peer
+# Interval between signed heartbeats published while there are no votes to sign, so peers
+# can tell a validator with nothing to vote on from one that is offline. 0 disables heartbeats
+heartbeat_interval = "{{ .Oracle.HeartbeatInterval }}"
+
+# Interval between pings sent to each peer on the oracle channels. Peers reply right away, and the
+# round trip times are reported by the oracle_peer_ping_rtt_seconds metric and the oracle_peers RPC
+# route, to monitor the health of the oracle mesh. Only peers speaking oracle protocol version 3 or
+# later are pinged. 0 disables pings
+ping_interval = "{{ .Oracle.PingInterval }}"
+
+# Interval between probes signed by the node key and relayed by every node across the oracle mesh.
+# The time probes take to propagate from each origin is reported by the
+# oracle_probe_propagation_seconds metric and the oracle_peers RPC route. Only peers speaking oracle
+# protocol version 4 or later are sent probes. 0 disables sending probes, the probes of other nodes
+# are still relayed
+probe_interval = "{{ .Oracle.ProbeInterval }}"
+
+# Max allowable size for votes that can be gossiped from peer to peer,
+# also the receive message capacity of the oracle channels carrying batches of votes
 max_gossip_msg_size = {{ .Oracle.MaxGossipMsgSize }}
 
+# Priority of the oracle channel relative to the other p2p channels (1-100)
+channel_priority = {{ .Oracle.ChannelPriority }}
+
+# Number of batches of votes that can be queued for sending to a peer on the oracle channel (1-1000).
+# Each queued batch takes up to max_gossip_msg_size bytes of memory per peer
+channel_send_queue_capacity = {{ .Oracle.ChannelSendQueueCapacity }}
+
+# Max total size of the gossiped batches of votes kept in memory. Beyond this, the batches of
+# other validators with the oldest signed timestamp are evicted. 0 does not bound the batches
+max_gossip_buffer_bytes = {{ .Oracle.MaxGossipBufferBytes }}
+
+# Budget of the memory taken by the votes kept by the oracle, for nodes with little memory, such as
+# RPC nodes relaying votes with 2-4 GB of RAM. 80% of it bounds the gossiped batches of votes, along
+# with max_gossip_buffer_bytes, and 20% bounds the data of votes gossiped by data hash, the oldest
+# data received from peers being evicted first. The data of our own votes is only evicted once
+# pruned, as our batches refer to it. The send queues of peers are bounded separately, by
+# channel_send_queue_capacity. 0 only bounds the gossiped batches, by max_gossip_buffer_bytes
+max_memory_bytes = {{ .Oracle.MaxMemoryBytes }}
+
+# Max number of votes of a single oracle ID waiting to be signed, to bound the votes kept from a
+# misconfigured adapter producing votes e.g. on every tick. Further votes fetched for the oracle are
+# dropped, and counted in the oracle_overflow_votes metric, until its votes are pruned after
+# max_oracle_gossip_age. 0 does not bound the votes
+max_unsigned_votes_per_oracle = {{ .Oracle.MaxUnsignedVotesPerOracle }}
+
+# Max number of votes in a single signed batch, votes beyond this (or beyond max_gossip_msg_size)
+# are signed in follow-up batches
+max_votes_per_batch = {{ .Oracle.MaxVotesPerBatch }}
+
+# Max size of vote data gossiped inline. Larger data is replaced by its hash in signed batches
+# and fetched by peers on demand. 0 always gossips data inline
+max_inline_data_size = {{ .Oracle.MaxInlineDataSize }}
+
+# Receive message capacity of the oracle data channel, which carries the data of votes gossiped by
+# data hash. Votes with data too large to fit in a single message, less 64 bytes for the data hash
+# and encoding, or in the share of max_memory_bytes taken by vote data, are not signed. It must
+# hold a request for the data of max_votes_per_batch votes
+max_data_msg_size = {{ .Oracle.MaxDataMsgSize }}
+
+# Number of times a peer can misbehave on the oracle channels before we disconnect from it, e.g. by
+# relaying batches of votes with an invalid signature, which peers verify before relaying them.
+# Peers sending messages the oracle does not handle are disconnected right away. The misbehavior of
+# peers is reported by the oracle_peers RPC route. 0 never disconnects peers for misbehaving
+max_peer_misbehavior = {{ .Oracle.MaxPeerMisbehavior }}
+
+# Appends this node, and the time it received the batch, to the relay hops of the batches of
+# votes it relays, for diagnosing propagation paths. Relay hops are not signed
+record_relay_hops = {{ .Oracle.RecordRelayHops }}
+
+# Informational moniker and operator description (e.g. an organization or contact) set on the
+# batches of votes signed by this node, so that dashboards can show human readable signer names.
+# They are not signed, so consumers must not rely on them. Empty values are not set (max 128 bytes)
+signer_moniker = "{{ .Oracle.SignerMoniker }}"
+signer_operator = "{{ .Oracle.SignerOperator }}"
+
 # Enables sub account signing for votes
 enable_sub_account_signing = {{ .Oracle.EnableSubAccountSigning }}
 
 # Path to the JSON file containing the sub account key to use to sign oracle votes
 sub_account_key_file_path = "{{ .Oracle.SubAccountKeyFilePath }}"
 
+# gRPC address of a separate feeder process to fetch the votes to sign from, instead of the
+# application, so that the validator does not reach out to data providers itself. The feeder
+# serves the FetchOracleVotes ABCI method over gRPC. Use a unix socket
+# (e.g. unix:///var/run/feeder.sock) so that only local processes with access to the socket
+# file can feed votes. Leave empty to fetch votes from the application
+feeder_address = "{{ .Oracle.FeederAddress }}"
+
+# Route prefixed to signed vote batches that are also submitted as mempool transactions,
+# for networks where not every node runs the oracle reactor yet. Leave empty to disable
+tx_fallback_route = "{{ .Oracle.TxFallbackRoute }}"
+
 #######################################################
 ###         State Sync Configuration Options        ###
 #######################################################
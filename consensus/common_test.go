@@ -38,8 +38,6 @@ import (
 	"github.com/cometbft/cometbft/store"
 	"github.com/cometbft/cometbft/types"
 	cmttime "github.com/cometbft/cometbft/types/time"
-
-	oracletypes "github.com/cometbft/cometbft/oracle/service/types"
 )
 
 const (
@@ -433,8 +431,7 @@ func newStateWithConfigAndBlockStore(
 		panic(err)
 	}
 
-	oracleInfo := oracletypes.OracleInfo{}
-	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyAppConnCon, mempool, &oracleInfo, evpool, blockStore)
+	blockExec := sm.NewBlockExecutor(stateStore, log.TestingLogger(), proxyAppConnCon, mempool, nil, evpool, blockStore)
 	cs := NewState(thisConfig.Consensus, state, blockExec, blockStore, mempool, evpool)
 	cs.SetLogger(log.TestingLogger().With("module", "consensus"))
 	cs.SetPrivValidator(pv)
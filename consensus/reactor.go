@@ -102,6 +102,12 @@ func (conR *Reactor) OnStop() {
 	}
 }
 
+// CatchingUp returns true while the node is not yet taking part in consensus,
+// i.e. while it is syncing blocks or state, or replaying the WAL.
+func (conR *Reactor) CatchingUp() bool {
+	return conR.WaitSync() || !conR.conS.WALCaughtUp()
+}
+
 // SwitchToConsensus switches from block_sync mode to consensus mode.
 // It resets the state, turns off block_sync, and starts the consensus state-machine
 func (conR *Reactor) SwitchToConsensus(state sm.State, skipWAL bool) {
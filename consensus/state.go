@@ -9,6 +9,7 @@ import (
 	"os"
 	"runtime/debug"
 	"sort"
+	"sync/atomic"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
@@ -122,8 +123,9 @@ type State struct {
 	// a Write-Ahead Log ensures we can recover from any kind of crash
 	// and helps us avoid signing conflicting votes
 	wal          WAL
-	replayMode   bool // so we don't log signing errors during replay
-	doWALCatchup bool // determines if we even try to do the catchup
+	replayMode   bool        // so we don't log signing errors during replay
+	doWALCatchup bool        // determines if we even try to do the catchup
+	walCaughtUp  atomic.Bool // set once the WAL has been replayed on start
 
 	// for tests where we want to limit the number of transitions the state makes
 	nSteps int
@@ -385,6 +387,8 @@ func (cs *State) OnStart() error {
 		}
 	}
 
+	cs.walCaughtUp.Store(true)
+
 	if err := cs.evsw.Start(); err != nil {
 		return err
 	}
@@ -404,6 +408,12 @@ func (cs *State) OnStart() error {
 	return nil
 }
 
+// WALCaughtUp returns true once the state has replayed the WAL on start, or
+// skipped replaying it. It is safe to call concurrently.
+func (cs *State) WALCaughtUp() bool {
+	return cs.walCaughtUp.Load()
+}
+
 // timeoutRoutine: receive requests for timeouts on tickChan and fire timeouts on tockChan
 // receiveRoutine: serializes processing of proposoals, block parts, votes; coordinates state transitions
 func (cs *State) startRoutines(maxSteps int) {
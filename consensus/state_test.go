@@ -62,6 +62,19 @@ x * TestHalt1 - if we see +2/3 precommits after timing out into new round, we sh
 //----------------------------------------------------------------------------------------------------
 // ProposeSuite
 
+func TestStateWALCaughtUp(t *testing.T) {
+	cs1, _ := randState(1)
+	assert.False(t, cs1.WALCaughtUp())
+
+	require.NoError(t, cs1.Start())
+	t.Cleanup(func() {
+		if err := cs1.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	assert.True(t, cs1.WALCaughtUp())
+}
+
 func TestStateProposerSelection0(t *testing.T) {
 	cs1, vss := randState(4)
 	height, round := cs1.Height, cs1.Round
@@ -7,6 +7,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -23,9 +24,9 @@ import (
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	"github.com/cometbft/cometbft/libs/service"
 	mempl "github.com/cometbft/cometbft/mempool"
+	oracletypes "github.com/cometbft/cometbft/oracle/service/types"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/pex"
-	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
 	rpccore "github.com/cometbft/cometbft/rpc/core"
 	grpccore "github.com/cometbft/cometbft/rpc/grpc"
@@ -70,7 +71,7 @@ type Node struct {
 	bcReactor         p2p.Reactor       // for block-syncing
 	mempoolReactor    p2p.Reactor       // for gossipping transactions
 	mempool           mempl.Mempool
-	oracleReactor     oracle.Reactor
+	oracleReactor     *oracle.Reactor
 	stateSync         bool                    // whether the node should state sync on startup
 	stateSyncReactor  *statesync.Reactor      // for hosting and restoring state sync snapshots
 	stateSyncProvider statesync.StateProvider // provides state data for bootstrapping a node
@@ -131,6 +132,20 @@ func CustomReactors(reactors map[string]p2p.Reactor) Option {
 	}
 }
 
+// CustomOracleRunner replaces the runner producing the votes signed and
+// gossiped by the oracle reactor, e.g. to feed votes from an app-specific
+// pipeline while reusing the oracle gossip. It has no effect if the oracle
+// is disabled.
+func CustomOracleRunner(run oracle.Runner) Option {
+	return func(n *Node) {
+		if n.oracleReactor == nil {
+			n.Logger.Info("Oracle is disabled, ignoring the custom oracle runner")
+			return
+		}
+		oracle.WithRunner(run)(n.oracleReactor)
+	}
+}
+
 // StateProvider overrides the state provider used by state sync to retrieve trusted app hashes and
 // build a State object for bootstrapping the node.
 // WARNING: this interface is considered unstable and subject to change.
@@ -311,7 +326,7 @@ func NewNodeWithContext(ctx context.Context,
 		return nil, err
 	}
 
-	csMetrics, p2pMetrics, memplMetrics, smMetrics, abciMetrics, bsMetrics, ssMetrics := metricsProvider(genDoc.ChainID)
+	csMetrics, p2pMetrics, memplMetrics, smMetrics, abciMetrics, bsMetrics, ssMetrics, oracleMetrics, privvalMetrics := metricsProvider(genDoc.ChainID)
 
 	// Create the proxyApp and establish connections to the ABCI app (consensus, mempool, query).
 	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger, abciMetrics)
@@ -387,23 +402,15 @@ func NewNodeWithContext(ctx context.Context,
 		return nil, err
 	}
 
-	// Make OracleReactor
-	oracleSigningKey := privValidator
-	oraclePubKey := pubKey
-
-	if config.Oracle.EnableSubAccountSigning {
-		// use sub account to sign oracle votes instead
-		subAccountKey := privval.LoadFilePVEmptyState(config.Oracle.SubAccountKeyFile(config.RootDir), "")
-		oracleSigningKey = subAccountKey
-
-		oraclePubKey, err = subAccountKey.GetPubKey()
-		if err != nil {
-			return nil, fmt.Errorf("can't get oracle sub account pubkey: %w", err)
-		}
+	// Make OracleReactor, unless the oracle is disabled
+	oracleReactor, err := createOracleReactor(config, privValidator, pubKey, nodeKey, proxyApp, mempool, oracleMetrics, privvalMetrics)
+	if err != nil {
+		return nil, err
+	}
+	var oracleInfo *oracletypes.OracleInfo
+	if oracleReactor != nil {
+		oracleInfo = oracleReactor.OracleInfo
 	}
-
-	oracleReactor := oracle.NewReactor(config.Oracle, oraclePubKey, oracleSigningKey, proxyApp.Consensus())
-	oracleInfo := oracleReactor.OracleInfo
 
 	// make block executor for consensus and blocksync reactors to execute blocks
 	blockExec := sm.NewBlockExecutor(
@@ -435,7 +442,11 @@ func NewNodeWithContext(ctx context.Context,
 		privValidator, csMetrics, stateSync || blockSync, eventBus, consensusLogger, offlineStateSyncHeight,
 	)
 
-	oracleReactor.ConsensusState = consensusState
+	if oracleReactor != nil {
+		oracleReactor.ConsensusState = consensusState
+		// votes are only signed once state sync, block sync and WAL replay are done
+		oracleReactor.OracleInfo.SyncStatus = consensusReactor
+	}
 
 	err = stateStore.SetOfflineStateSyncHeight(0)
 	if err != nil {
@@ -517,7 +528,7 @@ func NewNodeWithContext(ctx context.Context,
 		bcReactor:        bcReactor,
 		mempoolReactor:   mempoolReactor,
 		mempool:          mempool,
-		oracleReactor:    *oracleReactor,
+		oracleReactor:    oracleReactor,
 		consensusState:   consensusState,
 		consensusReactor: consensusReactor,
 		stateSyncReactor: stateSyncReactor,
@@ -708,6 +719,9 @@ func (n *Node) ConfigureRPC() (*rpccore.Environment, error) {
 
 		Config: *n.config.RPC,
 	}
+	if n.oracleReactor != nil {
+		rpcCoreEnv.OracleReactor = n.oracleReactor
+	}
 	if err := rpcCoreEnv.InitGenesisChunks(); err != nil {
 		return nil, err
 	}
@@ -976,7 +990,6 @@ func makeNodeInfo(
 			bc.BlocksyncChannel,
 			cs.StateChannel, cs.DataChannel, cs.VoteChannel, cs.VoteSetBitsChannel,
 			mempl.MempoolChannel,
-			oracle.OracleChannel,
 			evidence.EvidenceChannel,
 			statesync.SnapshotChannel, statesync.ChunkChannel,
 		},
@@ -987,6 +1000,11 @@ func makeNodeInfo(
 		},
 	}
 
+	if config.Oracle.Enable {
+		nodeInfo.Channels = append(nodeInfo.Channels, oracle.OracleChannel, oracle.OracleCatchupChannel, oracle.OracleDataChannel)
+		nodeInfo.Other.OracleVersion = strconv.FormatUint(oracle.ProtocolVersion, 10)
+	}
+
 	if config.P2P.PexReactor {
 		nodeInfo.Channels = append(nodeInfo.Channels, pex.PexChannel)
 	}
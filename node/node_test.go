@@ -1,11 +1,14 @@
 package node
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
+	"runtime/pprof"
+	"strings"
 	"syscall"
 	"testing"
 	"time"
@@ -23,6 +26,7 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	mempl "github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/oracle"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/conn"
 	p2pmock "github.com/cometbft/cometbft/p2p/mock"
@@ -427,6 +431,52 @@ func TestMaxProposalBlockSize(t *testing.T) {
 	assert.EqualValues(t, partSet.ByteSize(), int64(pb.Size()))
 }
 
+func TestNodeOracleDisabled(t *testing.T) {
+	config := test.ResetTestRoot("node_oracle_disabled_test")
+	defer os.RemoveAll(config.RootDir)
+
+	config.Oracle.Enable = false
+	n, err := DefaultNewNode(config, log.TestingLogger())
+	require.NoError(t, err)
+
+	assert.Nil(t, n.Switch().Reactor("ORACLE"))
+	assert.Nil(t, n.oracleReactor)
+
+	channels := n.NodeInfo().(p2p.DefaultNodeInfo).Channels
+	assert.NotContains(t, channels, oracle.OracleChannel)
+	assert.NotContains(t, channels, oracle.OracleCatchupChannel)
+	assert.NotContains(t, channels, oracle.OracleDataChannel)
+
+	// the node runs without starting any of the oracle routines
+	before := oracleGoroutines(t)
+	require.NoError(t, n.Start())
+	defer n.Stop() //nolint:errcheck // ignore for tests
+	blocksSub, err := n.EventBus().Subscribe(context.Background(), "node_test", types.EventQueryNewBlock)
+	require.NoError(t, err)
+	select {
+	case <-blocksSub.Out():
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the node to produce a block")
+	}
+	assert.Equal(t, before, oracleGoroutines(t))
+}
+
+// oracleGoroutines returns the number of goroutines running code of the
+// oracle packages.
+func oracleGoroutines(t *testing.T) int {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, pprof.Lookup("goroutine").WriteTo(&buf, 2))
+	count := 0
+	for _, stack := range strings.Split(buf.String(), "\n\n") {
+		if strings.Contains(stack, "github.com/cometbft/cometbft/oracle") {
+			count++
+		}
+	}
+	return count
+}
+
 func TestNodeNewNodeCustomReactors(t *testing.T) {
 	config := test.ResetTestRoot("node_new_node_custom_reactors_test")
 	defer os.RemoveAll(config.RootDir)
@@ -13,6 +13,7 @@ import (
 
 	dbm "github.com/cometbft/cometbft-db"
 
+	abcicli "github.com/cometbft/cometbft/abci/client"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/blocksync"
 	cfg "github.com/cometbft/cometbft/config"
@@ -25,6 +26,8 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/light"
 	mempl "github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/oracle"
+	oracletypes "github.com/cometbft/cometbft/oracle/service/types"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/pex"
 	"github.com/cometbft/cometbft/privval"
@@ -78,13 +81,13 @@ func DefaultNewNode(config *cfg.Config, logger log.Logger) (*Node, error) {
 	)
 }
 
-// MetricsProvider returns a consensus, p2p and mempool Metrics.
-type MetricsProvider func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *proxy.Metrics, *blocksync.Metrics, *statesync.Metrics)
+// MetricsProvider returns a consensus, p2p, mempool, oracle and privval Metrics.
+type MetricsProvider func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *proxy.Metrics, *blocksync.Metrics, *statesync.Metrics, *oracletypes.Metrics, *privval.Metrics)
 
 // DefaultMetricsProvider returns Metrics build using Prometheus client library
 // if Prometheus is enabled. Otherwise, it returns no-op Metrics.
 func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
-	return func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *proxy.Metrics, *blocksync.Metrics, *statesync.Metrics) {
+	return func(chainID string) (*cs.Metrics, *p2p.Metrics, *mempl.Metrics, *sm.Metrics, *proxy.Metrics, *blocksync.Metrics, *statesync.Metrics, *oracletypes.Metrics, *privval.Metrics) {
 		if config.Prometheus {
 			return cs.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				p2p.PrometheusMetrics(config.Namespace, "chain_id", chainID),
@@ -92,9 +95,11 @@ func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
 				sm.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				proxy.PrometheusMetrics(config.Namespace, "chain_id", chainID),
 				blocksync.PrometheusMetrics(config.Namespace, "chain_id", chainID),
-				statesync.PrometheusMetrics(config.Namespace, "chain_id", chainID)
+				statesync.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				oracletypes.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				privval.PrometheusMetrics(config.Namespace, "chain_id", chainID)
 		}
-		return cs.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics(), proxy.NopMetrics(), blocksync.NopMetrics(), statesync.NopMetrics()
+		return cs.NopMetrics(), p2p.NopMetrics(), mempl.NopMetrics(), sm.NopMetrics(), proxy.NopMetrics(), blocksync.NopMetrics(), statesync.NopMetrics(), oracletypes.NopMetrics(), privval.NopMetrics()
 	}
 }
 
@@ -264,6 +269,50 @@ func createMempoolAndMempoolReactor(
 	}
 }
 
+// createOracleReactor creates the oracle reactor, signing votes with the
+// oracle sub account key if enabled, and probes with the node key. It returns
+// nil if the oracle is disabled.
+func createOracleReactor(
+	config *cfg.Config,
+	privValidator types.PrivValidator,
+	pubKey crypto.PubKey,
+	nodeKey *p2p.NodeKey,
+	proxyApp proxy.AppConns,
+	mempool mempl.Mempool,
+	oracleMetrics *oracletypes.Metrics,
+	privvalMetrics *privval.Metrics,
+) (*oracle.Reactor, error) {
+	if !config.Oracle.Enable {
+		return nil, nil
+	}
+
+	oracleSigningKey := privValidator
+	oraclePubKey := pubKey
+
+	if config.Oracle.EnableSubAccountSigning && !config.Oracle.RelayOnly {
+		// use sub account to sign oracle votes instead
+		subAccountKey := privval.LoadFilePVEmptyState(config.Oracle.SubAccountKeyFile(config.RootDir), "")
+		oracleSigningKey = subAccountKey
+
+		var err error
+		oraclePubKey, err = subAccountKey.GetPubKey()
+		if err != nil {
+			return nil, fmt.Errorf("can't get oracle sub account pubkey: %w", err)
+		}
+	}
+
+	oracleSigningKey = privval.NewOracleMetricsSigner(oracleSigningKey, privvalMetrics)
+
+	options := []oracle.ReactorOption{oracle.ReactorMetrics(oracleMetrics), oracle.WithNodeKey(nodeKey.PrivKey)}
+	if config.Oracle.FeederAddress != "" && !config.Oracle.RelayOnly {
+		// not required to be up when the node starts, votes are fetched once connected
+		feederClient := abcicli.NewGRPCClient(config.Oracle.FeederAddress, false)
+		options = append(options, oracle.WithFeeder(feederClient))
+	}
+
+	return oracle.NewReactor(config.Oracle, oraclePubKey, oracleSigningKey, proxyApp.Consensus(), mempool, options...), nil
+}
+
 func createEvidenceReactor(config *cfg.Config, dbProvider cfg.DBProvider,
 	stateStore sm.Store, blockStore *store.BlockStore, logger log.Logger,
 ) (*evidence.Reactor, *evidence.Pool, error) {
@@ -413,7 +462,7 @@ func createSwitch(config *cfg.Config,
 	p2pMetrics *p2p.Metrics,
 	peerFilters []p2p.PeerFilterFunc,
 	mempoolReactor p2p.Reactor,
-	oracleReactor p2p.Reactor,
+	oracleReactor *oracle.Reactor,
 	bcReactor p2p.Reactor,
 	stateSyncReactor *statesync.Reactor,
 	consensusReactor *cs.Reactor,
@@ -429,7 +478,9 @@ func createSwitch(config *cfg.Config,
 		p2p.SwitchPeerFilters(peerFilters...),
 	)
 	sw.SetLogger(p2pLogger)
-	sw.AddReactor("ORACLE", oracleReactor)
+	if oracleReactor != nil {
+		sw.AddReactor("ORACLE", oracleReactor)
+	}
 	if config.Mempool.Type != cfg.MempoolTypeNop {
 		sw.AddReactor("MEMPOOL", mempoolReactor)
 	}
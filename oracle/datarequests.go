@@ -0,0 +1,92 @@
+package oracle
+
+import (
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+const (
+	// dataRequestTimeout is how long a response to a request for the data of
+	// a vote is awaited, later responses are dropped and the data can be
+	// requested again.
+	dataRequestTimeout = 10 * time.Second
+
+	// maxOutstandingDataRequests bounds the data hashes awaiting a response
+	// from a peer, further hashes are not requested from it until some are
+	// responded to or time out.
+	maxOutstandingDataRequests = 4096
+)
+
+// dataRequests is a thread-safe record of the data hashes requested from
+// peers, so that only data that was requested is accepted from them.
+type dataRequests struct {
+	mtx   cmtsync.Mutex
+	peers map[p2p.ID]map[string]time.Time
+}
+
+func newDataRequests() *dataRequests {
+	return &dataRequests{
+		peers: make(map[p2p.ID]map[string]time.Time),
+	}
+}
+
+// Request records that the data of the hashes is requested from the peer at
+// now, and returns the hashes to request. Hashes already awaiting a response
+// from the peer are left out.
+func (dr *dataRequests) Request(id p2p.ID, hashes [][]byte, now time.Time) [][]byte {
+	dr.mtx.Lock()
+	defer dr.mtx.Unlock()
+
+	requested, ok := dr.peers[id]
+	if !ok {
+		requested = make(map[string]time.Time)
+		dr.peers[id] = requested
+	}
+	for hash, at := range requested {
+		if now.Sub(at) >= dataRequestTimeout {
+			delete(requested, hash)
+		}
+	}
+
+	toRequest := make([][]byte, 0, len(hashes))
+	for _, hash := range hashes {
+		if len(requested) >= maxOutstandingDataRequests {
+			break
+		}
+		if _, ok := requested[string(hash)]; ok {
+			continue
+		}
+		requested[string(hash)] = now
+		toRequest = append(toRequest, hash)
+	}
+	return toRequest
+}
+
+// Responded records that the peer responded with the data of the hash at
+// now. It returns false if the data was not requested from the peer, or the
+// request timed out.
+func (dr *dataRequests) Responded(id p2p.ID, hash []byte, now time.Time) bool {
+	dr.mtx.Lock()
+	defer dr.mtx.Unlock()
+
+	requested, ok := dr.peers[id]
+	if !ok {
+		return false
+	}
+	at, ok := requested[string(hash)]
+	if !ok {
+		return false
+	}
+	delete(requested, string(hash))
+	return now.Sub(at) < dataRequestTimeout
+}
+
+// Remove forgets the requests made to the peer.
+func (dr *dataRequests) Remove(id p2p.ID) {
+	dr.mtx.Lock()
+	defer dr.mtx.Unlock()
+
+	delete(dr.peers, id)
+}
@@ -0,0 +1,48 @@
+package oracle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataRequests(t *testing.T) {
+	dr := newDataRequests()
+	now := time.Unix(1000, 0)
+
+	// responses to data that was not requested are not accepted
+	assert.False(t, dr.Responded("a", []byte("x"), now))
+
+	assert.Equal(t, [][]byte{[]byte("x"), []byte("y")}, dr.Request("a", [][]byte{[]byte("x"), []byte("y")}, now))
+	// data already awaiting a response is not requested again
+	assert.Equal(t, [][]byte{[]byte("z")}, dr.Request("a", [][]byte{[]byte("x"), []byte("z")}, now))
+
+	// only the peer the data was requested from can respond, once
+	assert.False(t, dr.Responded("b", []byte("x"), now))
+	assert.True(t, dr.Responded("a", []byte("x"), now))
+	assert.False(t, dr.Responded("a", []byte("x"), now))
+
+	// late responses are not accepted
+	assert.False(t, dr.Responded("a", []byte("y"), now.Add(dataRequestTimeout)))
+	// and timed out requests are made again
+	assert.Equal(t, [][]byte{[]byte("z")}, dr.Request("a", [][]byte{[]byte("z")}, now.Add(dataRequestTimeout)))
+
+	dr.Remove("a")
+	assert.False(t, dr.Responded("a", []byte("z"), now))
+}
+
+func TestDataRequestsBound(t *testing.T) {
+	dr := newDataRequests()
+	now := time.Unix(1000, 0)
+
+	hashes := make([][]byte, maxOutstandingDataRequests+1)
+	for i := range hashes {
+		hashes[i] = []byte{byte(i >> 8), byte(i)}
+	}
+	assert.Len(t, dr.Request("a", hashes, now), maxOutstandingDataRequests)
+	assert.Empty(t, dr.Request("a", hashes[maxOutstandingDataRequests:], now))
+
+	assert.True(t, dr.Responded("a", hashes[0], now))
+	assert.Len(t, dr.Request("a", hashes[maxOutstandingDataRequests:], now), 1)
+}
@@ -0,0 +1,78 @@
+package oracle
+
+import (
+	"time"
+
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+// gossipTargets selects the peers votes are gossiped to. With a fanout of
+// zero votes are gossiped to every peer. Otherwise, every interval, fanout
+// random peers are selected in addition to the priority peers, which are
+// always gossiped to, e.g. the peers known to be validators.
+type gossipTargets struct {
+	mtx        cmtsync.Mutex
+	rand       *cmtrand.Rand
+	fanout     int
+	interval   time.Duration
+	priority   map[p2p.ID]struct{}
+	selected   map[p2p.ID]struct{}
+	selectedAt time.Time
+}
+
+func newGossipTargets(rand *cmtrand.Rand, fanout int, interval time.Duration, priorityIDs []string) *gossipTargets {
+	priority := make(map[p2p.ID]struct{}, len(priorityIDs))
+	for _, id := range priorityIDs {
+		priority[p2p.ID(id)] = struct{}{}
+	}
+	return &gossipTargets{
+		rand:     rand,
+		fanout:   fanout,
+		interval: interval,
+		priority: priority,
+		selected: make(map[p2p.ID]struct{}),
+	}
+}
+
+// Includes returns true if votes are to be gossiped to the peer with the given
+// ID during the current interval. Random peers are selected again among the
+// given peers once the interval has passed.
+func (gt *gossipTargets) Includes(id p2p.ID, peers func() []p2p.Peer) bool {
+	if gt.fanout == 0 {
+		return true
+	}
+	if _, ok := gt.priority[id]; ok {
+		return true
+	}
+
+	gt.mtx.Lock()
+	defer gt.mtx.Unlock()
+
+	if time.Since(gt.selectedAt) >= gt.interval {
+		gt.selected = gt.selectRandom(peers())
+		gt.selectedAt = time.Now()
+	}
+	_, ok := gt.selected[id]
+	return ok
+}
+
+// selectRandom returns up to fanout random peers which are not priority peers.
+func (gt *gossipTargets) selectRandom(peers []p2p.Peer) map[p2p.ID]struct{} {
+	candidates := make([]p2p.ID, 0, len(peers))
+	for _, peer := range peers {
+		if _, ok := gt.priority[peer.ID()]; !ok {
+			candidates = append(candidates, peer.ID())
+		}
+	}
+
+	selected := make(map[p2p.ID]struct{}, gt.fanout)
+	for _, idx := range gt.rand.Perm(len(candidates)) {
+		if len(selected) == gt.fanout {
+			break
+		}
+		selected[candidates[idx]] = struct{}{}
+	}
+	return selected
+}
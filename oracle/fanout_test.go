@@ -0,0 +1,48 @@
+package oracle
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/mock"
+)
+
+func TestGossipTargets(t *testing.T) {
+	peers := make([]p2p.Peer, 5)
+	for i := range peers {
+		peers[i] = mock.NewPeer(net.IP{127, 0, 0, byte(i + 1)})
+	}
+	listPeers := func() []p2p.Peer { return peers }
+
+	countIncluded := func(gt *gossipTargets) int {
+		included := 0
+		for _, peer := range peers {
+			if gt.Includes(peer.ID(), listPeers) {
+				included++
+			}
+		}
+		return included
+	}
+
+	t.Run("all peers without fanout", func(t *testing.T) {
+		assert.Equal(t, len(peers), countIncluded(newGossipTargets(cmtrand.NewRand(), 0, time.Hour, nil)))
+	})
+
+	t.Run("fanout random peers and priority peers", func(t *testing.T) {
+		gt := newGossipTargets(cmtrand.NewRand(), 2, time.Hour, []string{string(peers[0].ID())})
+		require.Equal(t, 3, countIncluded(gt))
+		assert.True(t, gt.Includes(peers[0].ID(), listPeers))
+		// the selection is kept until the interval passes
+		assert.Equal(t, 3, countIncluded(gt))
+	})
+
+	t.Run("fanout beyond peers", func(t *testing.T) {
+		assert.Equal(t, len(peers), countIncluded(newGossipTargets(cmtrand.NewRand(), 10, time.Hour, nil)))
+	})
+}
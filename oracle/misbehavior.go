@@ -0,0 +1,124 @@
+package oracle
+
+import (
+	"sort"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Reasons a peer misbehaved on the oracle channels.
+const (
+	// the batch signature has an unsupported account or sign type, or its
+	// public key does not match the sign type, or the probe public key is not
+	// a node key
+	misbehaviorInvalidSigner = "invalid_signer"
+	// the batch or probe signature does not verify
+	misbehaviorInvalidSignature = "invalid_signature"
+	// the data of a vote does not match its data hash
+	misbehaviorInvalidData = "invalid_data"
+	// the peer requested the data of more votes than fit in a batch
+	misbehaviorOversizedDataRequest = "oversized_data_request"
+	// the peer sent a message the reactor does not handle
+	misbehaviorUnknownMessage = "unknown_message"
+)
+
+// maxMisbehavingPeers bounds the number of peers whose misbehavior is
+// remembered, the reports of peers which misbehaved least recently are
+// dropped first.
+const maxMisbehavingPeers = 1000
+
+// peerMisbehavior is a thread-safe record of the misbehavior of peers on the
+// oracle channels, by reason. Reports are kept after peers disconnect, so
+// that it can be told why a peer was disconnected.
+type peerMisbehavior struct {
+	mtx     cmtsync.Mutex
+	reports map[p2p.ID]map[string]*types.OraclePeerMisbehavior
+}
+
+func newPeerMisbehavior() *peerMisbehavior {
+	return &peerMisbehavior{
+		reports: make(map[p2p.ID]map[string]*types.OraclePeerMisbehavior),
+	}
+}
+
+// Report records that the peer misbehaved for the given reason at now, and
+// returns the number of times it misbehaved for any reason.
+func (pm *peerMisbehavior) Report(id p2p.ID, reason string, now time.Time) int {
+	pm.mtx.Lock()
+	defer pm.mtx.Unlock()
+
+	reasons, ok := pm.reports[id]
+	if !ok {
+		if len(pm.reports) >= maxMisbehavingPeers {
+			pm.evictLocked()
+		}
+		reasons = make(map[string]*types.OraclePeerMisbehavior)
+		pm.reports[id] = reasons
+	}
+	report, ok := reasons[reason]
+	if !ok {
+		report = &types.OraclePeerMisbehavior{PeerID: string(id), Reason: reason, FirstSeen: now}
+		reasons[reason] = report
+	}
+	report.Count++
+	report.LastSeen = now
+
+	count := 0
+	for _, report := range reasons {
+		count += report.Count
+	}
+	return count
+}
+
+// Stopped records that we disconnected from the peer for the given reason.
+func (pm *peerMisbehavior) Stopped(id p2p.ID, reason string) {
+	pm.mtx.Lock()
+	defer pm.mtx.Unlock()
+
+	if report, ok := pm.reports[id][reason]; ok {
+		report.Stopped = true
+	}
+}
+
+// evictLocked drops the reports of the peer which misbehaved least recently.
+func (pm *peerMisbehavior) evictLocked() {
+	var (
+		oldest     p2p.ID
+		oldestSeen time.Time
+	)
+	for id, reasons := range pm.reports {
+		var lastSeen time.Time
+		for _, report := range reasons {
+			if report.LastSeen.After(lastSeen) {
+				lastSeen = report.LastSeen
+			}
+		}
+		if oldest == "" || lastSeen.Before(oldestSeen) {
+			oldest, oldestSeen = id, lastSeen
+		}
+	}
+	delete(pm.reports, oldest)
+}
+
+// Reports returns a copy of the reports, sorted by peer and reason.
+func (pm *peerMisbehavior) Reports() []types.OraclePeerMisbehavior {
+	pm.mtx.Lock()
+	defer pm.mtx.Unlock()
+
+	reports := make([]types.OraclePeerMisbehavior, 0, len(pm.reports))
+	for _, reasons := range pm.reports {
+		for _, report := range reasons {
+			reports = append(reports, *report)
+		}
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		if reports[i].PeerID != reports[j].PeerID {
+			return reports[i].PeerID < reports[j].PeerID
+		}
+		return reports[i].Reason < reports[j].Reason
+	})
+	return reports
+}
@@ -0,0 +1,51 @@
+package oracle
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestPeerMisbehavior(t *testing.T) {
+	pm := newPeerMisbehavior()
+	first := time.Unix(10, 0)
+	last := time.Unix(20, 0)
+
+	assert.Equal(t, 1, pm.Report("b", misbehaviorInvalidSignature, first))
+	assert.Equal(t, 2, pm.Report("b", misbehaviorInvalidSignature, last))
+	// the count is over every reason
+	assert.Equal(t, 3, pm.Report("b", misbehaviorInvalidData, last))
+	assert.Equal(t, 1, pm.Report("a", misbehaviorUnknownMessage, first))
+	pm.Stopped("a", misbehaviorUnknownMessage)
+
+	assert.Equal(t, []types.OraclePeerMisbehavior{
+		{PeerID: "a", Reason: misbehaviorUnknownMessage, Count: 1, FirstSeen: first, LastSeen: first, Stopped: true},
+		{PeerID: "b", Reason: misbehaviorInvalidData, Count: 1, FirstSeen: last, LastSeen: last},
+		{PeerID: "b", Reason: misbehaviorInvalidSignature, Count: 2, FirstSeen: first, LastSeen: last},
+	}, pm.Reports())
+}
+
+func TestPeerMisbehaviorEviction(t *testing.T) {
+	pm := newPeerMisbehavior()
+	for i := 0; i < maxMisbehavingPeers; i++ {
+		pm.Report(p2p.ID(fmt.Sprintf("peer%d", i)), misbehaviorInvalidData, time.Unix(int64(i), 0))
+	}
+	// the peer which misbehaved least recently is dropped, rather than the
+	// peer which first misbehaved
+	pm.Report("peer0", misbehaviorInvalidSignature, time.Unix(maxMisbehavingPeers, 0))
+	pm.Report("new", misbehaviorInvalidData, time.Unix(maxMisbehavingPeers+1, 0))
+
+	peers := make(map[string]bool)
+	for _, report := range pm.Reports() {
+		peers[report.PeerID] = true
+	}
+	assert.Len(t, peers, maxMisbehavingPeers)
+	assert.True(t, peers["peer0"])
+	assert.True(t, peers["new"])
+	assert.False(t, peers["peer1"])
+}
@@ -0,0 +1,135 @@
+// Package oracletest provides an in-memory network of oracle reactors for
+// testing the oracle gossip without real listeners.
+package oracletest
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/oracle"
+	"github.com/cometbft/cometbft/oracle/service/runner/runnertest"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Network is a set of validators running oracle reactors, connected over
+// in-memory p2p connections. The validators share a consensus state, and
+// each validator fetches votes from its own scripted app and signs probes
+// with its own node key.
+type Network struct {
+	Reactors       []*oracle.Reactor
+	Apps           []*runnertest.App
+	PrivVals       []types.PrivValidator
+	NodeKeys       []crypto.PrivKey
+	Switches       []*p2p.Switch
+	ConsensusState *runnertest.ConsensusState
+}
+
+// NewNetwork starts n validators with the given oracle config, connecting
+// the validators i and j for which connect is called. Use ConnectAll or
+// ConnectLine, or a custom topology.
+func NewNetwork(n int, cfg *config.OracleConfig, connect func(switches []*p2p.Switch, i, j int)) *Network {
+	net := &Network{
+		Reactors: make([]*oracle.Reactor, n),
+		Apps:     make([]*runnertest.App, n),
+		PrivVals: make([]types.PrivValidator, n),
+		NodeKeys: make([]crypto.PrivKey, n),
+	}
+
+	validators := make([]*types.Validator, n)
+	for i := 0; i < n; i++ {
+		net.PrivVals[i] = types.NewMockPV()
+		pubKey, err := net.PrivVals[i].GetPubKey()
+		if err != nil {
+			panic(err)
+		}
+		validators[i] = types.NewValidator(pubKey, 10)
+	}
+	net.ConsensusState = runnertest.NewConsensusState(time.Now(), validators...)
+
+	for i := 0; i < n; i++ {
+		pubKey, err := net.PrivVals[i].GetPubKey()
+		if err != nil {
+			panic(err)
+		}
+		net.Apps[i] = runnertest.NewApp()
+		net.NodeKeys[i] = ed25519.GenPrivKey()
+		net.Reactors[i] = oracle.NewReactor(cfg, pubKey, net.PrivVals[i], net.Apps[i], nil, oracle.WithNodeKey(net.NodeKeys[i]))
+		net.Reactors[i].ConsensusState = net.ConsensusState
+	}
+
+	net.Switches = make([]*p2p.Switch, n)
+	for i := 0; i < n; i++ {
+		sw := p2p.MakeSwitch(config.DefaultP2PConfig(), i, func(i int, sw *p2p.Switch) *p2p.Switch {
+			sw.AddReactor("ORACLE", net.Reactors[i])
+			sw.AddReactor("PEERSTATE", NewPeerStateReactor())
+			return sw
+		})
+		// advertise the oracle protocol version, as the node does
+		nodeInfo := sw.NodeInfo().(p2p.DefaultNodeInfo)
+		nodeInfo.Other.OracleVersion = strconv.FormatUint(oracle.ProtocolVersion, 10)
+		sw.SetNodeInfo(nodeInfo)
+		net.Switches[i] = sw
+	}
+	if err := p2p.StartSwitches(net.Switches); err != nil {
+		panic(err)
+	}
+
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			connect(net.Switches, i, j)
+		}
+	}
+	return net
+}
+
+// Stop stops all the switches and their reactors.
+func (net *Network) Stop() error {
+	for _, sw := range net.Switches {
+		if err := sw.Stop(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ConnectAll connects every pair of validators.
+func ConnectAll(switches []*p2p.Switch, i, j int) {
+	p2p.Connect2Switches(switches, i, j)
+}
+
+// ConnectLine only connects adjacent validators, so that votes have to be
+// relayed to reach validators further away.
+func ConnectLine(switches []*p2p.Switch, i, j int) {
+	if j == i+1 {
+		p2p.Connect2Switches(switches, i, j)
+	}
+}
+
+//-----------------------------------------------------------------------------
+
+// PeerStateReactor sets a peer state on every peer, which the oracle reactor
+// waits for before gossiping and which is otherwise set by the consensus
+// reactor.
+type PeerStateReactor struct {
+	p2p.BaseReactor
+}
+
+func NewPeerStateReactor() *PeerStateReactor {
+	r := &PeerStateReactor{}
+	r.BaseReactor = *p2p.NewBaseReactor("PeerState", r)
+	return r
+}
+
+// InitPeer implements p2p.Reactor.
+func (r *PeerStateReactor) InitPeer(peer p2p.Peer) p2p.Peer {
+	peer.Set(types.PeerStateKey, peerState{})
+	return peer
+}
+
+type peerState struct{}
+
+func (peerState) GetHeight() int64 { return 1 }
@@ -0,0 +1,168 @@
+package oracletest_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/oracle/oracletest"
+	"github.com/cometbft/cometbft/p2p"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+)
+
+func TestNetworkConverges(t *testing.T) {
+	for name, connect := range map[string]func([]*p2p.Switch, int, int){
+		"all":  oracletest.ConnectAll,
+		"line": oracletest.ConnectLine,
+	} {
+		t.Run(name, func(t *testing.T) {
+			const n = 4
+			net := oracletest.NewNetwork(n, config.TestOracleConfig(), connect)
+			t.Cleanup(func() {
+				if err := net.Stop(); err != nil {
+					t.Error(err)
+				}
+			})
+
+			for i, app := range net.Apps {
+				app.QueueVotes(&oracleproto.Vote{OracleId: "oracle", Timestamp: time.Now().Unix(), Data: strconv.Itoa(i)})
+			}
+
+			require.Eventually(t, func() bool {
+				for _, r := range net.Reactors {
+					if len(r.OracleInfo.State.CurrentBatches()) != n {
+						return false
+					}
+				}
+				return true
+			}, 5*time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+func TestNetworkConvergesWithFanout(t *testing.T) {
+	const n = 8
+	for _, fanout := range []int{0, 1, 3} {
+		t.Run("fanout "+strconv.Itoa(fanout), func(t *testing.T) {
+			cfg := config.TestOracleConfig()
+			cfg.GossipFanout = fanout
+			net := oracletest.NewNetwork(n, cfg, oracletest.ConnectAll)
+			t.Cleanup(func() {
+				if err := net.Stop(); err != nil {
+					t.Error(err)
+				}
+			})
+
+			start := time.Now()
+			for i, app := range net.Apps {
+				app.QueueVotes(&oracleproto.Vote{OracleId: "oracle", Timestamp: time.Now().Unix(), Data: strconv.Itoa(i)})
+			}
+
+			require.Eventually(t, func() bool {
+				for _, r := range net.Reactors {
+					if len(r.OracleInfo.State.CurrentBatches()) != n {
+						return false
+					}
+				}
+				return true
+			}, 10*time.Second, 10*time.Millisecond)
+			t.Logf("converged in %v", time.Since(start))
+		})
+	}
+}
+
+func TestNetworkRecordsRelayHops(t *testing.T) {
+	const n = 3
+	cfg := config.TestOracleConfig()
+	cfg.RecordRelayHops = true
+	net := oracletest.NewNetwork(n, cfg, oracletest.ConnectLine)
+	t.Cleanup(func() {
+		if err := net.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	net.Apps[0].QueueVotes(&oracleproto.Vote{OracleId: "oracle", Timestamp: time.Now().Unix(), Data: "1"})
+
+	var batches []*oracleproto.GossipedVotes
+	require.Eventually(t, func() bool {
+		batches = net.Reactors[2].OracleInfo.State.CurrentBatches()
+		return len(batches) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the batch is relayed by the validator in between
+	hops := batches[0].RelayHops
+	require.Len(t, hops, 2)
+	assert.Equal(t, string(net.Switches[1].NodeInfo().ID()), hops[0].NodeId)
+	assert.Equal(t, string(net.Switches[2].NodeInfo().ID()), hops[1].NodeId)
+	assert.LessOrEqual(t, hops[0].ReceivedAt, hops[1].ReceivedAt)
+}
+
+func TestNetworkMeasuresPingLatency(t *testing.T) {
+	const n = 3
+	cfg := config.TestOracleConfig()
+	cfg.PingInterval = 20 * time.Millisecond
+	net := oracletest.NewNetwork(n, cfg, oracletest.ConnectAll)
+	t.Cleanup(func() {
+		if err := net.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// every node measures the round trip time to each of its peers
+	require.Eventually(t, func() bool {
+		for _, r := range net.Reactors {
+			if len(r.PeerLatency()) != n-1 {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	for _, latency := range net.Reactors[0].PeerLatency() {
+		assert.Positive(t, latency.Pings)
+		assert.LessOrEqual(t, latency.MinRTT, latency.MaxRTT)
+	}
+}
+
+func TestNetworkMeasuresProbePropagation(t *testing.T) {
+	const n = 3
+	cfg := config.TestOracleConfig()
+	cfg.ProbeInterval = 100 * time.Millisecond
+	net := oracletest.NewNetwork(n, cfg, oracletest.ConnectLine)
+	t.Cleanup(func() {
+		if err := net.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// every node receives the probes of every other node, including those it
+	// is not connected to
+	require.Eventually(t, func() bool {
+		for _, r := range net.Reactors {
+			if len(r.ProbePropagation()) != n-1 {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// the probes of the first node are relayed to the last one by the node in
+	// between
+	origin := string(p2p.PubKeyToID(net.NodeKeys[0].PubKey()))
+	var found bool
+	for _, propagation := range net.Reactors[n-1].ProbePropagation() {
+		if propagation.OriginID != origin {
+			continue
+		}
+		found = true
+		assert.Positive(t, propagation.Probes)
+		assert.EqualValues(t, 1, propagation.LastHops)
+		assert.LessOrEqual(t, propagation.MinLatency, propagation.MaxLatency)
+	}
+	assert.True(t, found)
+}
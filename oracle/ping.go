@@ -0,0 +1,113 @@
+package oracle
+
+import (
+	"sort"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/types"
+)
+
+// maxPendingPings bounds the pings awaiting a pong from a peer, the oldest
+// ping is dropped when another is sent, e.g. to a peer which never replies.
+const maxPendingPings = 8
+
+// peerPings is a thread-safe record of the pings sent to peers, and of the
+// round trip times of the pongs they replied with.
+type peerPings struct {
+	mtx   cmtsync.Mutex
+	peers map[p2p.ID]*peerPing
+}
+
+type peerPing struct {
+	// times the pings awaiting a pong were sent at, in unix milliseconds,
+	// the oldest first
+	pending []int64
+	latency types.OraclePeerLatency
+}
+
+func newPeerPings() *peerPings {
+	return &peerPings{
+		peers: make(map[p2p.ID]*peerPing),
+	}
+}
+
+// Sent records that a ping was sent to the peer at sentAt.
+func (pp *peerPings) Sent(id p2p.ID, sentAt int64) {
+	pp.mtx.Lock()
+	defer pp.mtx.Unlock()
+
+	ping, ok := pp.peers[id]
+	if !ok {
+		ping = &peerPing{latency: types.OraclePeerLatency{PeerID: string(id)}}
+		pp.peers[id] = ping
+	}
+	if len(ping.pending) >= maxPendingPings {
+		ping.pending = ping.pending[1:]
+	}
+	ping.pending = append(ping.pending, sentAt)
+}
+
+// Received records the pong the peer replied to the ping sent at sentAt
+// with, received at now, and returns the round trip time. It returns false if
+// no ping sent at sentAt awaits a pong from the peer.
+func (pp *peerPings) Received(id p2p.ID, sentAt int64, now time.Time) (time.Duration, bool) {
+	pp.mtx.Lock()
+	defer pp.mtx.Unlock()
+
+	ping, ok := pp.peers[id]
+	if !ok {
+		return 0, false
+	}
+	i := 0
+	for i < len(ping.pending) && ping.pending[i] != sentAt {
+		i++
+	}
+	if i == len(ping.pending) {
+		return 0, false
+	}
+	ping.pending = append(ping.pending[:i], ping.pending[i+1:]...)
+
+	rtt := now.Sub(time.UnixMilli(sentAt))
+	if rtt < 0 {
+		rtt = 0
+	}
+	latency := &ping.latency
+	if latency.Pings == 0 || rtt < latency.MinRTT {
+		latency.MinRTT = rtt
+	}
+	if rtt > latency.MaxRTT {
+		latency.MaxRTT = rtt
+	}
+	latency.Pings++
+	latency.LastRTT = rtt
+	latency.LastSeen = now
+	return rtt, true
+}
+
+// Remove forgets the pings of the peer.
+func (pp *peerPings) Remove(id p2p.ID) {
+	pp.mtx.Lock()
+	defer pp.mtx.Unlock()
+
+	delete(pp.peers, id)
+}
+
+// Latencies returns the round trip times of the peers which replied to at
+// least one ping, sorted by peer.
+func (pp *peerPings) Latencies() []types.OraclePeerLatency {
+	pp.mtx.Lock()
+	defer pp.mtx.Unlock()
+
+	latencies := make([]types.OraclePeerLatency, 0, len(pp.peers))
+	for _, ping := range pp.peers {
+		if ping.latency.Pings > 0 {
+			latencies = append(latencies, ping.latency)
+		}
+	}
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i].PeerID < latencies[j].PeerID
+	})
+	return latencies
+}
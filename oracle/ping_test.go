@@ -0,0 +1,52 @@
+package oracle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerPings(t *testing.T) {
+	pp := newPeerPings()
+	sentAt := time.UnixMilli(1000)
+
+	// pongs without a pending ping are ignored
+	_, ok := pp.Received("a", sentAt.UnixMilli(), sentAt)
+	assert.False(t, ok)
+
+	pp.Sent("a", sentAt.UnixMilli())
+	assert.Empty(t, pp.Latencies())
+	rtt, ok := pp.Received("a", sentAt.UnixMilli(), sentAt.Add(30*time.Millisecond))
+	require.True(t, ok)
+	assert.Equal(t, 30*time.Millisecond, rtt)
+
+	// a pong replying to the same ping twice is only counted once
+	_, ok = pp.Received("a", sentAt.UnixMilli(), sentAt.Add(40*time.Millisecond))
+	assert.False(t, ok)
+
+	// a pong slower than the ping interval still counts
+	pp.Sent("a", sentAt.Add(time.Second).UnixMilli())
+	pp.Sent("a", sentAt.Add(2*time.Second).UnixMilli())
+	_, ok = pp.Received("a", sentAt.Add(2*time.Second).UnixMilli(), sentAt.Add(2*time.Second+10*time.Millisecond))
+	require.True(t, ok)
+
+	// only the latest pings await a pong
+	for i := 0; i < maxPendingPings; i++ {
+		pp.Sent("a", sentAt.Add(time.Duration(3+i)*time.Second).UnixMilli())
+	}
+	_, ok = pp.Received("a", sentAt.Add(time.Second).UnixMilli(), sentAt.Add(3*time.Second))
+	assert.False(t, ok)
+
+	latencies := pp.Latencies()
+	require.Len(t, latencies, 1)
+	assert.Equal(t, "a", latencies[0].PeerID)
+	assert.Equal(t, 2, latencies[0].Pings)
+	assert.Equal(t, 10*time.Millisecond, latencies[0].LastRTT)
+	assert.Equal(t, 10*time.Millisecond, latencies[0].MinRTT)
+	assert.Equal(t, 30*time.Millisecond, latencies[0].MaxRTT)
+
+	pp.Remove("a")
+	assert.Empty(t, pp.Latencies())
+}
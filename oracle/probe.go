@@ -0,0 +1,177 @@
+package oracle
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/protoio"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/p2p"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// maxProbeAge bounds how long before, or after, our clock a probe can
+	// have been sent at, other probes are neither recorded nor relayed
+	maxProbeAge = time.Minute
+
+	// minProbeInterval bounds how often the probes of an origin are recorded
+	// and relayed, probes sent sooner after the last one are dropped
+	minProbeInterval = time.Second
+
+	// maxProbeOrigins bounds the number of origins whose probes are tracked,
+	// the origins seen least recently are dropped first
+	maxProbeOrigins = 1000
+)
+
+// probeOrigins is a thread-safe record of the probes received from each
+// origin, and of the time they took to propagate to us.
+type probeOrigins struct {
+	mtx     cmtsync.Mutex
+	origins map[p2p.ID]*probeOrigin
+}
+
+type probeOrigin struct {
+	// time the last recorded probe was sent at, in unix milliseconds
+	lastSentAt  int64
+	propagation types.OracleProbePropagation
+}
+
+func newProbeOrigins() *probeOrigins {
+	return &probeOrigins{
+		origins: make(map[p2p.ID]*probeOrigin),
+	}
+}
+
+// IsNew returns true if a probe of the origin sent at sentAt would be
+// recorded, i.e. if it was sent at least minProbeInterval after the last
+// recorded probe of the origin.
+func (po *probeOrigins) IsNew(id p2p.ID, sentAt int64) bool {
+	po.mtx.Lock()
+	defer po.mtx.Unlock()
+
+	origin, ok := po.origins[id]
+	return !ok || sentAt-origin.lastSentAt >= minProbeInterval.Milliseconds()
+}
+
+// Received records the probe of the origin sent at sentAt and relayed by hops
+// nodes, received at now, and returns the time it took to propagate. It
+// returns false if the probe is not new.
+func (po *probeOrigins) Received(id p2p.ID, sentAt int64, hops uint32, now time.Time) (time.Duration, bool) {
+	po.mtx.Lock()
+	defer po.mtx.Unlock()
+
+	origin, ok := po.origins[id]
+	if !ok {
+		if len(po.origins) >= maxProbeOrigins {
+			po.evictLocked()
+		}
+		origin = &probeOrigin{propagation: types.OracleProbePropagation{OriginID: string(id)}}
+		po.origins[id] = origin
+	} else if sentAt-origin.lastSentAt < minProbeInterval.Milliseconds() {
+		return 0, false
+	}
+	origin.lastSentAt = sentAt
+
+	latency := now.Sub(time.UnixMilli(sentAt))
+	if latency < 0 {
+		latency = 0
+	}
+	propagation := &origin.propagation
+	if propagation.Probes == 0 || latency < propagation.MinLatency {
+		propagation.MinLatency = latency
+	}
+	if latency > propagation.MaxLatency {
+		propagation.MaxLatency = latency
+	}
+	propagation.Probes++
+	propagation.LastLatency = latency
+	propagation.LastHops = hops
+	propagation.LastSeen = now
+	return latency, true
+}
+
+// evictLocked drops the origin seen least recently.
+func (po *probeOrigins) evictLocked() {
+	var (
+		oldestID p2p.ID
+		oldest   time.Time
+	)
+	for id, origin := range po.origins {
+		if oldestID == "" || origin.propagation.LastSeen.Before(oldest) {
+			oldestID, oldest = id, origin.propagation.LastSeen
+		}
+	}
+	delete(po.origins, oldestID)
+}
+
+// Propagation returns the propagation times of the probes of each origin,
+// sorted by origin.
+func (po *probeOrigins) Propagation() []types.OracleProbePropagation {
+	po.mtx.Lock()
+	defer po.mtx.Unlock()
+
+	propagation := make([]types.OracleProbePropagation, 0, len(po.origins))
+	for _, origin := range po.origins {
+		propagation = append(propagation, origin.propagation)
+	}
+	sort.Slice(propagation, func(i, j int) bool {
+		return propagation[i].OriginID < propagation[j].OriginID
+	})
+	return propagation
+}
+
+// probeSignBytes returns the bytes of the probe signed by its origin for the
+// given chain. The relay hops are not signed, as relaying nodes increment
+// them.
+func probeSignBytes(chainID string, probe *oracleproto.OracleProbe) ([]byte, error) {
+	return protoio.MarshalDelimited(&oracleproto.CanonicalOracleProbe{
+		PubKey:  probe.PubKey,
+		SentAt:  probe.SentAt,
+		ChainId: chainID,
+	})
+}
+
+// signProbe returns a probe sent at sentAt, signed by the node key for the
+// given chain.
+func signProbe(chainID string, nodeKey crypto.PrivKey, sentAt int64) (*oracleproto.OracleProbe, error) {
+	probe := &oracleproto.OracleProbe{
+		PubKey: nodeKey.PubKey().Bytes(),
+		SentAt: sentAt,
+	}
+	signBytes, err := probeSignBytes(chainID, probe)
+	if err != nil {
+		return nil, err
+	}
+	probe.Signature, err = nodeKey.Sign(signBytes)
+	if err != nil {
+		return nil, err
+	}
+	return probe, nil
+}
+
+// probePubKey returns the node key of the origin of the probe. Node keys are
+// ed25519 keys.
+func probePubKey(probe *oracleproto.OracleProbe) (crypto.PubKey, error) {
+	if len(probe.PubKey) != ed25519.PubKeySize {
+		return nil, errors.New("invalid ed25519 public key size")
+	}
+	return ed25519.PubKey(probe.PubKey), nil
+}
+
+// verifyProbe verifies that the probe is signed by its node key for the given
+// chain.
+func verifyProbe(chainID string, probe *oracleproto.OracleProbe, pubKey crypto.PubKey) error {
+	signBytes, err := probeSignBytes(chainID, probe)
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(signBytes, probe.Signature) {
+		return errors.New("invalid probe signature")
+	}
+	return nil
+}
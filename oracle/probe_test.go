@@ -0,0 +1,93 @@
+package oracle
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/p2p"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+)
+
+func TestProbeOrigins(t *testing.T) {
+	po := newProbeOrigins()
+	sentAt := time.UnixMilli(1000)
+
+	assert.True(t, po.IsNew("a", sentAt.UnixMilli()))
+	latency, ok := po.Received("a", sentAt.UnixMilli(), 2, sentAt.Add(80*time.Millisecond))
+	require.True(t, ok)
+	assert.Equal(t, 80*time.Millisecond, latency)
+
+	// the same probe received from another peer is only counted once
+	assert.False(t, po.IsNew("a", sentAt.UnixMilli()))
+	_, ok = po.Received("a", sentAt.UnixMilli(), 1, sentAt.Add(90*time.Millisecond))
+	assert.False(t, ok)
+
+	// as are older probes, and probes sent too soon after the last one
+	assert.False(t, po.IsNew("a", sentAt.Add(-time.Second).UnixMilli()))
+	assert.False(t, po.IsNew("a", sentAt.Add(minProbeInterval/2).UnixMilli()))
+
+	// a probe from an origin whose clock is ahead of ours propagates instantly
+	_, ok = po.Received("a", sentAt.Add(time.Second).UnixMilli(), 1, sentAt.Add(time.Second-5*time.Millisecond))
+	require.True(t, ok)
+	_, ok = po.Received("b", sentAt.UnixMilli(), 0, sentAt.Add(20*time.Millisecond))
+	require.True(t, ok)
+
+	propagation := po.Propagation()
+	require.Len(t, propagation, 2)
+	assert.Equal(t, "a", propagation[0].OriginID)
+	assert.Equal(t, 2, propagation[0].Probes)
+	assert.Zero(t, propagation[0].LastLatency)
+	assert.Zero(t, propagation[0].MinLatency)
+	assert.Equal(t, 80*time.Millisecond, propagation[0].MaxLatency)
+	assert.EqualValues(t, 1, propagation[0].LastHops)
+	assert.Equal(t, "b", propagation[1].OriginID)
+}
+
+func TestProbeOriginsEviction(t *testing.T) {
+	po := newProbeOrigins()
+	now := time.UnixMilli(1000)
+
+	for i := 0; i < maxProbeOrigins; i++ {
+		_, ok := po.Received(p2p.ID(strconv.Itoa(i)), now.UnixMilli(), 0, now.Add(time.Duration(i)*time.Millisecond))
+		require.True(t, ok)
+	}
+	require.Len(t, po.Propagation(), maxProbeOrigins)
+
+	// the origin seen least recently is dropped
+	_, ok := po.Received("new", now.UnixMilli(), 0, now.Add(time.Hour))
+	require.True(t, ok)
+	propagation := po.Propagation()
+	assert.Len(t, propagation, maxProbeOrigins)
+	for _, origin := range propagation {
+		assert.NotEqual(t, "0", origin.OriginID)
+	}
+}
+
+func TestSignProbe(t *testing.T) {
+	nodeKey := ed25519.GenPrivKey()
+	probe, err := signProbe("chain", nodeKey, 1000)
+	require.NoError(t, err)
+
+	pubKey, err := probePubKey(probe)
+	require.NoError(t, err)
+	assert.Equal(t, nodeKey.PubKey(), pubKey)
+	require.NoError(t, verifyProbe("chain", probe, pubKey))
+
+	// relay hops are not signed
+	probe.Hops = 3
+	require.NoError(t, verifyProbe("chain", probe, pubKey))
+
+	// probes are only valid for the chain they were signed for
+	assert.Error(t, verifyProbe("other", probe, pubKey))
+
+	probe.SentAt++
+	assert.Error(t, verifyProbe("chain", probe, pubKey))
+
+	_, err = probePubKey(&oracleproto.OracleProbe{PubKey: []byte{0x01}})
+	assert.Error(t, err)
+}
@@ -4,25 +4,27 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
+	"strconv"
 	"time"
 
-	"github.com/cometbft/cometbft/crypto/ed25519"
-	"github.com/cometbft/cometbft/crypto/secp256k1"
-	"github.com/cometbft/cometbft/crypto/sr25519"
-
 	"github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/proxy"
 
 	"github.com/cometbft/cometbft/crypto"
 
+	abcicli "github.com/cometbft/cometbft/abci/client"
 	abcitypes "github.com/cometbft/cometbft/abci/types"
-	cs "github.com/cometbft/cometbft/consensus"
 	"github.com/cometbft/cometbft/libs/log"
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	cmtstrings "github.com/cometbft/cometbft/libs/strings"
+	mempl "github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/oracle/service/runner"
 	oracletypes "github.com/cometbft/cometbft/oracle/service/types"
 	"github.com/cometbft/cometbft/oracle/service/utils"
+	"github.com/cometbft/cometbft/oracle/verify"
 	"github.com/cometbft/cometbft/p2p"
 	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
 	"github.com/cometbft/cometbft/types"
@@ -30,14 +32,66 @@ import (
 )
 
 const (
+	// OracleChannel carries batches of votes the first time they are sent to
+	// a peer.
 	OracleChannel = byte(0x42)
+	// OracleCatchupChannel carries batches of votes that were already sent to
+	// a peer, which are resent for peers that missed them. It has a lower
+	// priority so catch-up bursts don't delay fresh votes.
+	OracleCatchupChannel = byte(0x43)
+	// OracleDataChannel carries requests and responses for the data of votes
+	// gossiped by data hash, pings and probes.
+	OracleDataChannel = byte(0x44)
+
+	// ProtocolVersion is the version of the oracle gossip protocol spoken by
+	// the reactor. It is advertised to peers in the node info, so that
+	// changes to the protocol can be rolled out gradually by only using them
+	// with peers speaking a recent enough version. Peers which do not
+	// advertise a version are assumed to speak version 1.
+	ProtocolVersion = 4
+
+	// legacySigningProtocolVersion is the last protocol version whose peers
+	// may sign batches over all of their votes rather than over their merkle
+	// root, as peers which do not advertise a version predate the signing
+	// over the merkle root
+	legacySigningProtocolVersion = 1
+
+	// heartbeatProtocolVersion is the first protocol version whose peers
+	// handle batches without votes as heartbeats
+	heartbeatProtocolVersion = 2
+
+	// pingProtocolVersion is the first protocol version whose peers reply to
+	// pings, older peers disconnect on receiving them
+	pingProtocolVersion = 3
+
+	// probeProtocolVersion is the first protocol version whose peers relay
+	// probes, older peers disconnect on receiving them
+	probeProtocolVersion = 4
 
 	// PeerCatchupSleepIntervalMS defines how much time to sleep if a peer is behind
 	PeerCatchupSleepIntervalMS = 100
 
+	// maxRelayHops bounds the number of relay hops recorded on a batch
+	maxRelayHops = 8
+
+	// maxSendBackoff bounds the wait before retrying to send to a peer whose
+	// send queue stayed full
+	maxSendBackoff = 10 * time.Second
+
 	MaxActiveIDs = math.MaxUint16
 )
 
+var (
+	_ p2p.Wrapper           = &oracleproto.OracleDataRequest{}
+	_ p2p.Wrapper           = &oracleproto.OracleDataResponse{}
+	_ p2p.Wrapper           = &oracleproto.OraclePing{}
+	_ p2p.Wrapper           = &oracleproto.OraclePong{}
+	_ p2p.Wrapper           = &oracleproto.OracleProbe{}
+	_ p2p.Unwrapper         = &oracleproto.Message{}
+	_ p2p.EncodingValidator = &oracleproto.GossipedVotes{}
+	_ p2p.EncodingValidator = &oracleproto.Message{}
+)
+
 // Reactor handles mempool tx broadcasting amongst peers.
 // It maintains a map from peer ID to counter, to prevent gossiping txs to the
 // peers you received it from.
@@ -45,38 +99,124 @@ type Reactor struct {
 	p2p.BaseReactor
 	OracleInfo     *oracletypes.OracleInfo
 	ids            *oracleIDs
-	ConsensusState *cs.State
+	valIndex       *validatorIndex
+	targets        *gossipTargets
+	verified       *verifiedBatches
+	stress         *consensusStress
+	misbehavior    *peerMisbehavior
+	pings          *peerPings
+	dataRequests   *dataRequests
+	probes         *probeOrigins
+	ConsensusState runner.ConsensusState
+
+	// key the probes sent by the reactor are signed with, if any, and the
+	// node ID it belongs to
+	nodeKey crypto.PrivKey
+	nodeID  p2p.ID
+
+	// client of the feeder process votes are fetched from, if not the app
+	feederClient abcicli.Client
+	run          Runner
+	// cancels the context of the runner when the reactor stops
+	cancel context.CancelFunc
+	// source of the jitter of send retries and of the selection of peers
+	rand *cmtrand.Rand
 }
 
-// NewReactor returns a new Reactor with the given config and mempool.
-func NewReactor(config *config.OracleConfig, pubKey crypto.PubKey, privValidator types.PrivValidator, proxyApp proxy.AppConnConsensus) *Reactor {
-	gossipVoteBuffer := &oracletypes.GossipVoteBuffer{
-		Buffer: make(map[string]*oracleproto.GossipedVotes),
-	}
-	unsignedVoteBuffer := &oracletypes.UnsignedVoteBuffer{
-		Buffer: []*oracleproto.Vote{},
-	}
+// Runner produces the votes signed and gossiped by the reactor, by sending
+// them to OracleInfo.SignVotesChan. It is started when the reactor starts,
+// unless the reactor is relay only, and is expected to return once ctx is
+// canceled, when the reactor stops.
+type Runner func(ctx context.Context, oracleInfo *oracletypes.OracleInfo, consensusState runner.ConsensusState)
 
+// ReactorOption sets an optional parameter on the Reactor.
+type ReactorOption func(*Reactor)
+
+// NewReactor returns a new Reactor with the given config and mempool.
+func NewReactor(config *config.OracleConfig, pubKey crypto.PubKey, privValidator types.PrivValidator, proxyApp proxy.AppConnConsensus, mempool mempl.Mempool, options ...ReactorOption) *Reactor {
 	oracleInfo := &oracletypes.OracleInfo{
-		Config:             config,
-		UnsignedVoteBuffer: unsignedVoteBuffer,
-		GossipVoteBuffer:   gossipVoteBuffer,
-		SignVotesChan:      make(chan *oracleproto.Vote, 1024),
-		PubKey:             pubKey,
-		PrivValidator:      privValidator,
-		ProxyApp:           proxyApp,
-		BlockTimestamps:    []int64{},
+		Config:        config,
+		State:         oracletypes.NewOracleState(config.GossipBufferBytes(), config.MaxUnsignedVotesPerOracle),
+		SignVotesChan: make(chan *oracleproto.Vote, config.SignQueueCapacity),
+		PubKey:        pubKey,
+		PrivValidator: privValidator,
+		ProxyApp:      proxyApp,
+		VoteSource:    proxyApp,
+		Mempool:       mempool,
+		Metrics:       oracletypes.NopMetrics(),
+		BatchLatency:  &oracletypes.BatchLatency{},
 	}
+	// the clock is read through oracleInfo, as it may be set by an option
+	oracleInfo.VoteDataStore = oracletypes.NewVoteDataStore(config.VoteDataBytes(), oracleInfo.Now)
 
+	rand := cmtrand.NewRand()
 	oracleR := &Reactor{
-		OracleInfo: oracleInfo,
-		ids:        newOracleIDs(),
+		OracleInfo:   oracleInfo,
+		ids:          newOracleIDs(),
+		valIndex:     newValidatorIndex(),
+		targets:      newGossipTargets(rand, config.GossipFanout, config.GossipInterval, cmtstrings.SplitAndTrimEmpty(config.GossipPriorityPeerIDs, ",", " ")),
+		verified:     newVerifiedBatches(verifiedBatchesCacheSize),
+		stress:       newConsensusStress(config.ConsensusStressThreshold),
+		misbehavior:  newPeerMisbehavior(),
+		pings:        newPeerPings(),
+		dataRequests: newDataRequests(),
+		probes:       newProbeOrigins(),
+		run:          runner.Run,
+		rand:         rand,
 	}
 	oracleR.BaseReactor = *p2p.NewBaseReactor("Oracle", oracleR)
 
+	for _, option := range options {
+		option(oracleR)
+	}
+
 	return oracleR
 }
 
+// ReactorMetrics sets the metrics.
+func ReactorMetrics(metrics *oracletypes.Metrics) ReactorOption {
+	return func(oracleR *Reactor) { oracleR.OracleInfo.Metrics = metrics }
+}
+
+// WithFeeder fetches the votes to sign from a separate feeder process through
+// the given ABCI client, rather than from the app. The client is started and
+// stopped along with the reactor.
+func WithFeeder(client abcicli.Client) ReactorOption {
+	return func(oracleR *Reactor) {
+		oracleR.feederClient = client
+		oracleR.OracleInfo.VoteSource = client
+	}
+}
+
+// WithClock sets the clock batches are signed at, e.g. so that tests sign the
+// same batches on every run.
+func WithClock(clock func() time.Time) ReactorOption {
+	return func(oracleR *Reactor) { oracleR.OracleInfo.Clock = clock }
+}
+
+// WithRandSeed seeds the jitter of send retries and the selection of the
+// peers votes are gossiped to, e.g. so that tests gossip in the same order on
+// every run.
+func WithRandSeed(seed int64) ReactorOption {
+	return func(oracleR *Reactor) { oracleR.rand.Seed(seed) }
+}
+
+// WithNodeKey signs the probes sent across the oracle mesh with the node key,
+// probes are only sent by reactors with a node key.
+func WithNodeKey(nodeKey crypto.PrivKey) ReactorOption {
+	return func(oracleR *Reactor) {
+		oracleR.nodeKey = nodeKey
+		oracleR.nodeID = p2p.PubKeyToID(nodeKey.PubKey())
+	}
+}
+
+// WithRunner replaces the default runner, which fetches votes from the app
+// and signs them, e.g. to feed votes from an app-specific pipeline. Custom
+// runners can wrap runner.Run or reuse its building blocks.
+func WithRunner(run Runner) ReactorOption {
+	return func(oracleR *Reactor) { oracleR.run = run }
+}
+
 // InitPeer implements Reactor by creating a state for the peer.
 func (oracleR *Reactor) InitPeer(peer p2p.Peer) p2p.Peer {
 	oracleR.ids.ReserveForPeer(peer)
@@ -87,16 +227,78 @@ func (oracleR *Reactor) InitPeer(peer p2p.Peer) p2p.Peer {
 func (oracleR *Reactor) SetLogger(l log.Logger) {
 	oracleR.Logger = l
 	oracleR.BaseService.SetLogger(l)
+	if oracleR.feederClient != nil {
+		oracleR.feederClient.SetLogger(l.With("module", "oracle-feeder"))
+	}
 }
 
 // OnStart implements p2p.BaseReactor.
+// In relay only mode, only the pruning of the gossip buffer and the sending of
+// probes are started.
 func (oracleR *Reactor) OnStart() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	oracleR.cancel = cancel
+
+	if oracleR.nodeKey != nil && oracleR.OracleInfo.Config.ProbeInterval > 0 {
+		go oracleR.probeRoutine()
+	}
+
+	if oracleR.OracleInfo.Config.RelayOnly {
+		runner.PruneVoteBuffers(ctx, oracleR.OracleInfo, oracleR.ConsensusState)
+		return nil
+	}
+
 	go func() {
-		runner.Run(oracleR.OracleInfo, oracleR.ConsensusState)
+		// votes are only fetched once connected to the feeder, which may start
+		// after the node
+		if oracleR.feederClient != nil {
+			if err := oracleR.feederClient.Start(); err != nil {
+				oracleR.Logger.Error("Failed to connect to the oracle feeder", "err", err)
+				return
+			}
+		}
+		oracleR.OracleInfo.WarmUp(oracleR.OracleInfo.Config.WarmUpPeriod)
+		oracleR.run(ctx, oracleR.OracleInfo, oracleR.ConsensusState)
 	}()
 	return nil
 }
 
+// OnStop implements p2p.BaseReactor.
+func (oracleR *Reactor) OnStop() {
+	oracleR.cancel()
+	if oracleR.feederClient != nil && oracleR.feederClient.IsRunning() {
+		if err := oracleR.feederClient.Stop(); err != nil {
+			oracleR.Logger.Error("Failed to stop the oracle feeder client", "err", err)
+		}
+	}
+}
+
+// PauseSigning stops the node from signing new batches of votes, while still
+// relaying the batches of other validators.
+func (oracleR *Reactor) PauseSigning() error {
+	if oracleR.OracleInfo.Config.RelayOnly {
+		return errors.New("oracle is relay only and does not sign votes")
+	}
+	oracleR.OracleInfo.PauseSigning()
+	oracleR.Logger.Info("Paused signing oracle votes")
+	return nil
+}
+
+// ResumeSigning resumes signing new batches of votes.
+func (oracleR *Reactor) ResumeSigning() error {
+	if oracleR.OracleInfo.Config.RelayOnly {
+		return errors.New("oracle is relay only and does not sign votes")
+	}
+	oracleR.OracleInfo.ResumeSigning()
+	oracleR.Logger.Info("Resumed signing oracle votes")
+	return nil
+}
+
+// SigningPaused returns true if signing new batches of votes is paused.
+func (oracleR *Reactor) SigningPaused() bool {
+	return oracleR.OracleInfo.SigningPaused()
+}
+
 // GetChannels implements Reactor by returning the list of channels for this
 // reactor.
 func (oracleR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
@@ -106,24 +308,52 @@ func (oracleR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 	return []*p2p.ChannelDescriptor{
 		{
 			ID:                  OracleChannel,
-			Priority:            5,
+			Priority:            oracleR.OracleInfo.Config.ChannelPriority,
+			SendQueueCapacity:   oracleR.OracleInfo.Config.ChannelSendQueueCapacity,
+			RecvMessageCapacity: messageCap,
+			MessageType:         &oracleproto.GossipedVotes{},
+		},
+		{
+			ID:                  OracleCatchupChannel,
+			Priority:            1,
+			SendQueueCapacity:   2,
 			RecvMessageCapacity: messageCap,
 			MessageType:         &oracleproto.GossipedVotes{},
 		},
+		{
+			ID:                  OracleDataChannel,
+			Priority:            1,
+			SendQueueCapacity:   10,
+			RecvMessageCapacity: oracleR.OracleInfo.Config.MaxDataMsgSize,
+			MessageType:         &oracleproto.Message{},
+		},
 	}
 }
 
 // AddPeer implements Reactor.
-// It starts a broadcast routine ensuring all txs are forwarded to the given peer.
+// It starts a broadcast routine ensuring all txs are forwarded to the given peer,
+// if the peer advertised support for the oracle channel.
 func (oracleR *Reactor) AddPeer(peer p2p.Peer) {
+	if ni, ok := peer.NodeInfo().(p2p.DefaultNodeInfo); ok && !ni.HasChannel(OracleChannel) {
+		oracleR.Logger.Debug("Peer does not support the oracle channel, skipping gossip", "peer", peer.ID())
+		oracleR.OracleInfo.Metrics.SkippedPeers.Add(1)
+		return
+	}
+
 	go func() {
 		oracleR.broadcastVoteRoutine(peer)
 	}()
+
+	if oracleR.OracleInfo.Config.PingInterval > 0 && peerProtocolVersion(peer) >= pingProtocolVersion {
+		go oracleR.pingRoutine(peer)
+	}
 }
 
 // RemovePeer implements Reactor.
 func (oracleR *Reactor) RemovePeer(peer p2p.Peer, _ interface{}) {
 	oracleR.ids.Reclaim(peer)
+	oracleR.pings.Remove(peer.ID())
+	oracleR.dataRequests.Remove(peer.ID())
 	// broadcast routine checks if peer is gone and returns
 }
 
@@ -133,22 +363,17 @@ func (oracleR *Reactor) Receive(e p2p.Envelope) {
 	switch msg := e.Message.(type) {
 	case *oracleproto.GossipedVotes:
 		// get account and sign type of oracle votes
-		accountType, signType, err := utils.GetAccountSignTypeFromSignature(msg.Signature)
+		accountType, _, err := utils.GetAccountSignTypeFromSignature(msg.Signature)
 		if err != nil {
 			logrus.Errorf("unable to get account and sign type from signature: %v", msg.Signature)
+			oracleR.reportMisbehavior(e.Src, misbehaviorInvalidSigner, err)
 			return
 		}
-		var pubKey crypto.PubKey
-
 		// get pubkey based on sign type
-		if bytes.Equal(signType, oracletypes.Ed25519SignType) {
-			pubKey = ed25519.PubKey(msg.PubKey)
-		} else if bytes.Equal(signType, oracletypes.Sr25519SignType) {
-			pubKey = sr25519.PubKey(msg.PubKey)
-		} else if bytes.Equal(signType, oracletypes.Secp256k1SignType) {
-			pubKey = secp256k1.PubKey(msg.PubKey)
-		} else {
-			logrus.Errorf("unsupported sign type for validator with pubkey: %v, skipping gossip", hex.EncodeToString(msg.PubKey))
+		pubKey, err := verify.PubKey(msg)
+		if err != nil {
+			logrus.Errorf("unable to get pubkey of validator with pubkey: %v, skipping gossip: %v", hex.EncodeToString(msg.PubKey), err)
+			oracleR.reportMisbehavior(e.Src, misbehaviorInvalidSigner, err)
 			return
 		}
 
@@ -157,85 +382,465 @@ func (oracleR *Reactor) Receive(e p2p.Envelope) {
 			return
 		}
 
+		valAddr := oracletypes.ValAddressFromPubKey(pubKey)
+
 		// check if signer is main account or subaccount
 		if bytes.Equal(accountType, oracletypes.MainAccountSigPrefix) {
 			// is main account, verify if oracle votes are from validator
-			isVal := oracleR.ConsensusState.Validators.HasAddress(pubKey.Address())
+			isVal := oracleR.isValidator(valAddr)
 			if !isVal {
-				logrus.Debugf("validator: %v not found in validator set, skipping gossip", pubKey.Address().String())
+				logrus.Debugf("validator: %v not found in validator set, skipping gossip", valAddr.String())
 				return
 			}
 
 		} else if bytes.Equal(accountType, oracletypes.SubAccountSigPrefix) {
 			// is subaccount, verify if the corresponding main account is a validator
-			res, err := oracleR.OracleInfo.ProxyApp.DoesSubAccountBelongToVal(context.Background(), &abcitypes.RequestDoesSubAccountBelongToVal{Address: pubKey.Address()})
+			ctx, cancel := oracletypes.WithTimeout(context.Background(), oracleR.OracleInfo.Config.AppQueryTimeout)
+			res, err := oracleR.OracleInfo.ProxyApp.DoesSubAccountBelongToVal(ctx, &abcitypes.RequestDoesSubAccountBelongToVal{Address: pubKey.Address()})
+			cancel()
 
 			if err != nil {
-				logrus.Warnf("unable to check if subaccount: %v belongs to validator: %v", pubKey.Address().String(), err)
+				logrus.Warnf("unable to check if subaccount: %v belongs to validator: %v", valAddr.String(), err)
 				return
 			}
 
 			if !res.BelongsToVal {
-				logrus.Debugf("subaccount: %v does not belong to a validator, skipping gossip", pubKey.Address().String())
+				logrus.Debugf("subaccount: %v does not belong to a validator, skipping gossip", valAddr.String())
 				return
 			}
 
 		} else {
 			logrus.Errorf("unsupported account type for validator with pubkey: %v, skipping gossip", hex.EncodeToString(msg.PubKey))
+			oracleR.reportMisbehavior(e.Src, misbehaviorInvalidSigner, fmt.Errorf("unsupported account type %x", accountType))
 			return
 		}
 
-		// verify sig of incoming gossip vote, throw if verification fails
-		// signature starts from index 2 onwards due to the account and sign type prefix bytes
-		signatureWithoutPrefix, err := utils.GetSignatureWithoutPrefix(msg.Signature)
+		// verify sig and data of incoming gossip vote, throw if verification fails, unless the same
+		// batch was already verified when received from another peer
+		hash, err := hashBatch(msg)
 		if err != nil {
-			logrus.Errorf("unable to get signature without prefix, invalid signature: %v", msg.Signature)
+			logrus.Errorf("unable to hash gossip vote from validator: %v, skipping gossip", valAddr.String())
+			return
+		}
+		if !oracleR.verified.Has(hash) {
+			// the data of votes gossiped by data hash is not signed, only its hash is,
+			// so it is checked against the hash before the batch is merged or relayed
+			for _, vote := range msg.Votes {
+				if err := verify.ValidateVoteData(vote); err != nil {
+					logrus.Errorf("invalid data for vote %v from validator: %v, skipping gossip: %v", vote.OracleId, valAddr.String(), err)
+					oracleR.reportMisbehavior(e.Src, misbehaviorInvalidData, err)
+					return
+				}
+			}
+			chainID := oracleR.ConsensusState.GetState().ChainID
+			if err := verify.Signature(chainID, msg, pubKey); err != nil {
+				// peers predating the signing over the merkle root are not disconnected for their
+				// batches, so that a rolling upgrade does not partition the network
+				if peerProtocolVersion(e.Src) <= legacySigningProtocolVersion && verify.LegacySignature(chainID, msg, pubKey) == nil {
+					oracleR.Logger.Debug("Dropping batch of votes signed in the legacy form", "peer", e.Src.ID(), "signer", valAddr)
+					oracleR.OracleInfo.Metrics.LegacyBatches.Add(1)
+					return
+				}
+				logrus.Errorf("failed signature verification for validator: %v, skipping gossip: %v", valAddr.String(), err)
+				oracleR.reportMisbehavior(e.Src, misbehaviorInvalidSignature, err)
+				return
+			}
+			oracleR.verified.Add(hash)
 		}
-		if success := pubKey.VerifySignature(types.OracleVoteSignBytes(oracleR.ConsensusState.GetState().ChainID, msg), signatureWithoutPrefix); !success {
-			logrus.Errorf("failed signature verification for validator: %v, skipping gossip", pubKey.Address().String())
+
+		if oracleR.OracleInfo.Config.RecordRelayHops {
+			oracleR.recordRelayHop(msg)
+		}
+
+		// heartbeats carry no votes, they are kept apart from the batches so that they are
+		// not included in the oracle result tx
+		if len(msg.Votes) == 0 {
+			if oracleR.OracleInfo.State.MergeHeartbeat(valAddr, msg) {
+				oracleR.observeLastSeen(valAddr, msg)
+			}
 			return
 		}
 
 		preLockTime := time.Now().UnixMilli()
-		oracleR.OracleInfo.GossipVoteBuffer.Lock()
-		currentGossipVote, ok := oracleR.OracleInfo.GossipVoteBuffer.Buffer[pubKey.Address().String()]
-
-		if !ok {
-			// first gossipVote entry from this validator
-			oracleR.OracleInfo.GossipVoteBuffer.Buffer[pubKey.Address().String()] = msg
-		} else {
-			// existing gossipVote entry from this validator
-			previousTimestamp := currentGossipVote.SignedTimestamp
-			newTimestamp := msg.SignedTimestamp
-			// only replace if the gossipVote received has a later timestamp than our current one
-			if newTimestamp > previousTimestamp {
-				oracleR.OracleInfo.GossipVoteBuffer.Buffer[pubKey.Address().String()] = msg
+		// a validator may have multiple batches signed together, keyed by their batch sequence number,
+		// only replace a batch if the one received has a later timestamp than our current one
+		if oracleR.OracleInfo.State.MergeGossip(valAddr, msg) {
+			oracleR.observeLastSeen(valAddr, msg)
+			oracleR.requestMissingData(e.Src, msg)
+			if len(msg.RelayHops) > 0 {
+				oracleR.Logger.Debug("Received batch of votes", "signer", valAddr, "batch_seq", msg.BatchSeq, "relay_hops", msg.RelayHops)
 			}
+			oracleR.OracleInfo.Metrics.GossipBufferBytes.Set(float64(oracleR.OracleInfo.State.GossipBytes()))
 		}
-		oracleR.OracleInfo.GossipVoteBuffer.Unlock()
 		postLockTime := time.Now().UnixMilli()
 		diff := postLockTime - preLockTime
 		if diff > 100 {
 			logrus.Warnf("WARNING!!! Receiving gossip lock took %v milliseconds", diff)
 		}
+
+		oracleR.observeQuorumLatency()
+	case *oracleproto.OracleDataRequest:
+		if len(msg.DataHashes) > oracleR.OracleInfo.Config.MaxVotesPerBatch {
+			oracleR.reportMisbehavior(e.Src, misbehaviorOversizedDataRequest, fmt.Errorf("oracle data request for %d hashes exceeds max votes per batch", len(msg.DataHashes)))
+			return
+		}
+		for _, hash := range msg.DataHashes {
+			data, ok := oracleR.OracleInfo.VoteDataStore.Get(hash)
+			if !ok {
+				continue
+			}
+			if !e.Src.TrySend(p2p.Envelope{
+				ChannelID: OracleDataChannel,
+				Message:   &oracleproto.OracleDataResponse{DataHash: hash, Data: data},
+			}) {
+				return
+			}
+		}
+	case *oracleproto.OracleDataResponse:
+		// only data requested from the peer is accepted, so that peers can't fill
+		// the store with data no batch refers to
+		if !oracleR.dataRequests.Responded(e.Src.ID(), msg.DataHash, oracleR.OracleInfo.Now()) {
+			oracleR.Logger.Debug("Oracle data was not requested from peer, dropping", "peer", e.Src.ID())
+			return
+		}
+		if !oracleR.OracleInfo.VoteDataStore.AddWithHash(msg.DataHash, msg.Data) {
+			oracleR.Logger.Debug("Oracle data does not match its hash, dropping", "peer", e.Src.ID())
+			oracleR.reportMisbehavior(e.Src, misbehaviorInvalidData, errors.New("oracle data does not match its hash"))
+		}
+	case *oracleproto.OraclePing:
+		e.Src.TrySend(p2p.Envelope{
+			ChannelID: OracleDataChannel,
+			Message:   &oracleproto.OraclePong{SentAt: msg.SentAt},
+		})
+	case *oracleproto.OraclePong:
+		// pongs to pings that were not sent, or were already replied to, are ignored
+		if rtt, ok := oracleR.pings.Received(e.Src.ID(), msg.SentAt, oracleR.OracleInfo.Now()); ok {
+			oracleR.OracleInfo.Metrics.PeerPingRTTSeconds.With("peer_id", string(e.Src.ID())).Observe(rtt.Seconds())
+		}
+	case *oracleproto.OracleProbe:
+		oracleR.receiveProbe(e.Src, msg)
 	default:
 		logrus.Warn("unknown message type", "src", e.Src, "chId", e.ChannelID, "msg", e.Message)
-		oracleR.Switch.StopPeerForError(e.Src, fmt.Errorf("oracle cannot handle message of type: %T", e.Message))
+		oracleR.reportMisbehavior(e.Src, misbehaviorUnknownMessage, fmt.Errorf("oracle cannot handle message of type: %T", e.Message))
 		return
 	}
 
 	// broadcasting happens from go routines per peer
 }
 
+// reportMisbehavior records that the peer misbehaved for the given reason.
+// We disconnect from peers sending messages the oracle does not handle, and
+// from peers which misbehaved Config.MaxPeerMisbehavior times.
+func (oracleR *Reactor) reportMisbehavior(peer p2p.Peer, reason string, err error) {
+	count := oracleR.misbehavior.Report(peer.ID(), reason, oracleR.OracleInfo.Now())
+	oracleR.OracleInfo.Metrics.PeerMisbehavior.With("peer_id", string(peer.ID()), "reason", reason).Add(1)
+
+	maxMisbehavior := oracleR.OracleInfo.Config.MaxPeerMisbehavior
+	switch {
+	case reason == misbehaviorOversizedDataRequest || reason == misbehaviorUnknownMessage:
+	case maxMisbehavior > 0 && count >= maxMisbehavior:
+		err = fmt.Errorf("oracle peer misbehaved %d times, last with %s: %w", count, reason, err)
+	default:
+		return
+	}
+	oracleR.misbehavior.Stopped(peer.ID(), reason)
+	oracleR.Switch.StopPeerForError(peer, err)
+}
+
+// pingRoutine pings the peer every Config.PingInterval, to measure the round
+// trip time to it, until the peer or the reactor stops.
+func (oracleR *Reactor) pingRoutine(peer p2p.Peer) {
+	ticker := time.NewTicker(oracleR.OracleInfo.Config.PingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-peer.Quit():
+			return
+		case <-oracleR.Quit():
+			return
+		}
+
+		sentAt := oracleR.OracleInfo.Now().UnixMilli()
+		if peer.TrySend(p2p.Envelope{
+			ChannelID: OracleDataChannel,
+			Message:   &oracleproto.OraclePing{SentAt: sentAt},
+		}) {
+			oracleR.pings.Sent(peer.ID(), sentAt)
+		}
+	}
+}
+
+// probeRoutine sends a probe signed by the node key to every peer each
+// ProbeInterval, which the peers relay across the oracle mesh.
+func (oracleR *Reactor) probeRoutine() {
+	ticker := time.NewTicker(oracleR.OracleInfo.Config.ProbeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-oracleR.Quit():
+			return
+		}
+
+		sentAt := oracleR.OracleInfo.Now().UnixMilli()
+		probe, err := signProbe(oracleR.ConsensusState.GetState().ChainID, oracleR.nodeKey, sentAt)
+		if err != nil {
+			oracleR.Logger.Error("Failed to sign oracle probe", "err", err)
+			continue
+		}
+		oracleR.sendProbe(probe, "", "")
+	}
+}
+
+// receiveProbe records how long the probe took to propagate from its origin,
+// and relays it to our other peers, unless it was already received or it is
+// too old.
+func (oracleR *Reactor) receiveProbe(src p2p.Peer, probe *oracleproto.OracleProbe) {
+	now := oracleR.OracleInfo.Now()
+	sentAt := time.UnixMilli(probe.SentAt)
+	if now.Sub(sentAt) > maxProbeAge || sentAt.Sub(now) > maxProbeAge {
+		oracleR.Logger.Debug("Oracle probe is too old, dropping", "peer", src.ID(), "sent_at", probe.SentAt)
+		return
+	}
+
+	pubKey, err := probePubKey(probe)
+	if err != nil {
+		oracleR.reportMisbehavior(src, misbehaviorInvalidSigner, err)
+		return
+	}
+	// our own probes relayed back to us, and probes already received from
+	// another peer, are dropped before their signature is verified
+	origin := p2p.PubKeyToID(pubKey)
+	if origin == oracleR.nodeID || !oracleR.probes.IsNew(origin, probe.SentAt) {
+		return
+	}
+	if err := verifyProbe(oracleR.ConsensusState.GetState().ChainID, probe, pubKey); err != nil {
+		oracleR.reportMisbehavior(src, misbehaviorInvalidSignature, err)
+		return
+	}
+
+	latency, ok := oracleR.probes.Received(origin, probe.SentAt, probe.Hops, now)
+	if !ok {
+		return
+	}
+	oracleR.OracleInfo.Metrics.ProbePropagationSeconds.With("origin_id", string(origin)).Observe(latency.Seconds())
+
+	if probe.Hops < maxRelayHops {
+		oracleR.sendProbe(&oracleproto.OracleProbe{
+			PubKey:    probe.PubKey,
+			SentAt:    probe.SentAt,
+			Signature: probe.Signature,
+			Hops:      probe.Hops + 1,
+		}, origin, src.ID())
+	}
+}
+
+// sendProbe sends the probe to the peers speaking a protocol version which
+// relays probes, except to its origin and to the peer it was received from.
+// Probes are not retried, as the next probe is sent soon after.
+func (oracleR *Reactor) sendProbe(probe *oracleproto.OracleProbe, origin, src p2p.ID) {
+	for _, peer := range oracleR.peers() {
+		if peerProtocolVersion(peer) < probeProtocolVersion || peer.ID() == origin || peer.ID() == src {
+			continue
+		}
+		peer.TrySend(p2p.Envelope{
+			ChannelID: OracleDataChannel,
+			Message:   probe,
+		})
+	}
+}
+
+// ProbePropagation returns the time the probes of each node took to propagate
+// to us across the oracle mesh.
+func (oracleR *Reactor) ProbePropagation() []types.OracleProbePropagation {
+	return oracleR.probes.Propagation()
+}
+
+// PeerLatency returns the round trip times of the pings sent to peers on the
+// oracle channels.
+func (oracleR *Reactor) PeerLatency() []types.OraclePeerLatency {
+	return oracleR.pings.Latencies()
+}
+
+// PeerMisbehavior returns the reports of the misbehavior of peers on the
+// oracle channels, including of peers we disconnected from.
+func (oracleR *Reactor) PeerMisbehavior() []types.OraclePeerMisbehavior {
+	return oracleR.misbehavior.Reports()
+}
+
+// observeLastSeen records the time the batch or heartbeat received from addr
+// was signed.
+func (oracleR *Reactor) observeLastSeen(addr oracletypes.ValAddress, gossipVote *oracleproto.GossipedVotes) {
+	oracleR.OracleInfo.Metrics.LastSeenTimestamp.With("validator_address", addr.String()).Set(float64(gossipVote.SignedTimestamp))
+}
+
+// requestMissingData requests the data of votes in the batch that are
+// gossiped by data hash from the peer the batch was received from.
+func (oracleR *Reactor) requestMissingData(src p2p.Peer, gossipVote *oracleproto.GossipedVotes) {
+	if src == nil {
+		return
+	}
+	missing := oracleR.OracleInfo.VoteDataStore.MissingData(gossipVote.Votes)
+	missing = oracleR.dataRequests.Request(src.ID(), missing, oracleR.OracleInfo.Now())
+	if len(missing) == 0 {
+		return
+	}
+	src.TrySend(p2p.Envelope{
+		ChannelID: OracleDataChannel,
+		Message:   &oracleproto.OracleDataRequest{DataHashes: missing},
+	})
+}
+
+// isValidator returns true if the address belongs to the current validator
+// set. The validator index is rebuilt lazily whenever the last committed
+// height moves past the height it was built at.
+func (oracleR *Reactor) isValidator(addr oracletypes.ValAddress) bool {
+	oracleR.updateValidatorIndex()
+	return oracleR.valIndex.Has(addr)
+}
+
+// updateValidatorIndex rebuilds the validator index if the last committed
+// height has moved past the height it was built at.
+func (oracleR *Reactor) updateValidatorIndex() {
+	height := oracleR.ConsensusState.GetLastHeight()
+	if oracleR.valIndex.Height() != height {
+		_, validators := oracleR.ConsensusState.GetValidators()
+		oracleR.valIndex.Update(height, validators)
+		oracleR.pruneNonValidatorBatches()
+	}
+}
+
+// pruneNonValidatorBatches removes batches signed by main account keys that
+// are no longer part of the validator set, e.g. after a validator rotated its
+// key, so that the old and new key don't both have votes in the buffer.
+// Batches signed by sub accounts are kept, as they are not part of the
+// validator set.
+func (oracleR *Reactor) pruneNonValidatorBatches() {
+	ownAddr := oracletypes.ValAddressFromPubKey(oracleR.OracleInfo.PubKey)
+
+	pruned := oracleR.OracleInfo.State.RemoveBatches(func(key oracletypes.GossipVoteKey, gossipVote *oracleproto.GossipedVotes) bool {
+		if key.Address == ownAddr || oracleR.valIndex.Has(key.Address) {
+			return false
+		}
+		accountType, _, err := utils.GetAccountSignTypeFromSignature(gossipVote.Signature)
+		return err != nil || bytes.Equal(accountType, oracletypes.MainAccountSigPrefix)
+	})
+
+	if pruned > 0 {
+		oracleR.Logger.Debug("Pruned batches from keys no longer in the validator set", "batches", pruned)
+	}
+}
+
+// recordRelayHop appends this node to the relay hops of a batch received
+// from a peer, unless the batch already has maxRelayHops hops or would no
+// longer fit in a gossip message.
+func (oracleR *Reactor) recordRelayHop(msg *oracleproto.GossipedVotes) {
+	if oracleR.Switch == nil || len(msg.RelayHops) >= maxRelayHops {
+		return
+	}
+
+	msg.RelayHops = append(msg.RelayHops, &oracleproto.RelayHop{
+		NodeId:     string(oracleR.Switch.NodeInfo().ID()),
+		ReceivedAt: oracleR.OracleInfo.Now().UnixMilli(),
+	})
+	if msg.Size() > oracleR.OracleInfo.Config.MaxGossipMsgSize {
+		msg.RelayHops = msg.RelayHops[:len(msg.RelayHops)-1]
+	}
+}
+
+// VoteProof returns a proof that the validator, or sub account, with the given
+// address signed the vote for the oracle at the given timestamp. Only the
+// batches of votes currently gossiped are searched, so the vote must not have
+// been pruned yet.
+func (oracleR *Reactor) VoteProof(addr crypto.Address, oracleID string, timestamp int64) (*types.OracleVoteProof, error) {
+	valAddr := oracletypes.ValAddressFromBytes(addr)
+	for key, batch := range oracleR.OracleInfo.Snapshot().Batches {
+		if key.Address != valAddr {
+			continue
+		}
+		for i, vote := range batch.Votes {
+			if vote.OracleId == oracleID && vote.Timestamp == timestamp {
+				// include the data of votes gossiped by data hash, if known
+				filled := oracleR.OracleInfo.VoteDataStore.FillData([]*oracleproto.GossipedVotes{batch})[0]
+				return types.NewOracleVoteProof(oracletypes.WithoutUnsignedMetadata([]*oracleproto.GossipedVotes{filled})[0], i)
+			}
+		}
+	}
+	return nil, fmt.Errorf("no vote for oracle %v at timestamp %d signed by %v among the gossiped votes", oracleID, timestamp, valAddr)
+}
+
+// GossipedVotes returns copies of the batches of votes currently gossiped by
+// the node, including our own, which can be serialized without holding the
+// buffer locks.
+func (oracleR *Reactor) GossipedVotes() []*oracleproto.GossipedVotes {
+	return oracleR.OracleInfo.Snapshot().CurrentBatches()
+}
+
+// observeQuorumLatency records how long it took, since our latest batch was
+// signed, for batches at least as recent to be observed from validators
+// holding more than 2/3 of the voting power.
+func (oracleR *Reactor) observeQuorumLatency() {
+	batch := oracleR.OracleInfo.BatchLatency.PendingQuorum()
+	if batch == nil {
+		return
+	}
+
+	oracleR.updateValidatorIndex()
+
+	power := int64(0)
+	for key, gossipVote := range oracleR.OracleInfo.State.Batches() {
+		// only count each validator once, through its first batch
+		if key.BatchSeq == 0 && gossipVote.SignedTimestamp >= batch.SignedTimestamp {
+			power += oracleR.valIndex.VotingPower(key.Address)
+		}
+	}
+
+	if power*3 <= oracleR.valIndex.TotalVotingPower()*2 {
+		return
+	}
+
+	if latency, ok := oracleR.OracleInfo.BatchLatency.MarkQuorum(batch); ok {
+		oracleR.OracleInfo.Metrics.VoteLatencySeconds.With("stage", "quorum").Observe(latency.Seconds())
+	}
+}
+
 // PeerState describes the state of a peer.
 type PeerState interface {
 	GetHeight() int64
 }
 
+// consensusStressed returns whether consensus is stressed, in which case votes
+// are gossiped at a lower pace.
+func (oracleR *Reactor) consensusStressed() bool {
+	stressed, changed := oracleR.stress.Update(oracleR.ConsensusState.GetLastHeight())
+	if changed {
+		if stressed {
+			oracleR.Logger.Info("consensus is stressed, lowering the pace of gossip", "interval", oracleR.OracleInfo.Config.ConsensusStressGossipInterval)
+			oracleR.OracleInfo.Metrics.ConsensusStressed.Set(1)
+		} else {
+			oracleR.Logger.Info("consensus is no longer stressed, restoring the pace of gossip")
+			oracleR.OracleInfo.Metrics.ConsensusStressed.Set(0)
+		}
+	}
+	return stressed
+}
+
 // // Send new oracle votes to peer.
+// Votes are sent as soon as new batches are added to the gossip buffer, while
+// batches already sent are resent at most every Config.GossipInterval. While
+// consensus is stressed, votes are only sent every
+// Config.ConsensusStressGossipInterval.
 func (oracleR *Reactor) broadcastVoteRoutine(peer p2p.Peer) {
-	// gossip votes every x milliseconds, where x = Config.GossipInterval
 	interval := oracleR.OracleInfo.Config.GossipInterval
+	sendHeartbeats := peerProtocolVersion(peer) >= heartbeatProtocolVersion
+	lastCatchup := time.Time{}
+	sendFailures := 0
+
+	// batches already sent to the peer are resent on the catch-up channel
+	sent := make(map[*oracleproto.GossipedVotes]struct{})
 
 	for {
 		// In case of both next.NextWaitChan() and peer.Quit() are variable at the same time
@@ -262,39 +867,194 @@ func (oracleR *Reactor) broadcastVoteRoutine(peer p2p.Peer) {
 			continue
 		}
 
-		// only gossip votes that are younger than the latestAllowableTimestamp, which is the max(earliest block timestamp collected, current time - maxOracleGossipAge)
-		latestAllowableTimestamp := time.Now().Unix() - int64(oracleR.OracleInfo.Config.MaxOracleGossipAge)
-		if len(oracleR.OracleInfo.BlockTimestamps) == oracleR.OracleInfo.Config.MaxOracleGossipBlocksDelayed && oracleR.OracleInfo.BlockTimestamps[0] > latestAllowableTimestamp {
-			latestAllowableTimestamp = oracleR.OracleInfo.BlockTimestamps[0]
+		// with a limited fanout, only gossip to the peer while it is selected
+		if !oracleR.targets.Includes(peer.ID(), oracleR.peers) {
+			select {
+			case <-time.After(interval):
+				continue
+			case <-peer.Quit():
+				return
+			case <-oracleR.Quit():
+				return
+			}
+		}
+
+		stressed := oracleR.consensusStressed()
+		catchupInterval := interval
+		if stressed {
+			catchupInterval = oracleR.OracleInfo.Config.ConsensusStressGossipInterval
 		}
 
+		// only gossip votes that are younger than the latestAllowableTimestamp, which is the max(earliest block timestamp collected, current time - maxOracleGossipAge)
+		latestAllowableTimestamp := oracleR.OracleInfo.State.LatestAllowableTimestamp(oracleR.OracleInfo.Now().Unix(), oracleR.OracleInfo.Config.MaxOracleGossipAge, oracleR.OracleInfo.Config.MaxOracleGossipBlocksDelayed)
+		// batches of other validators are only relayed while younger than maxOracleRelayAge
+		latestRelayTimestamp := oracleR.latestRelayTimestamp()
+
+		// get the update notification before reading the buffer, so no update is missed
+		updated := oracleR.OracleInfo.State.Updated()
+
 		preLockTime := time.Now().UnixMilli()
-		oracleR.OracleInfo.GossipVoteBuffer.RLock()
 		votes := []*oracleproto.GossipedVotes{}
-		for _, gossipVote := range oracleR.OracleInfo.GossipVoteBuffer.Buffer {
+		for _, gossipVote := range oracleR.OracleInfo.State.CurrentBatches() {
 			// stop sending gossip votes that have passed the maxGossipVoteAge
-			if gossipVote.SignedTimestamp < latestAllowableTimestamp {
+			if gossipVote.SignedTimestamp < latestAllowableTimestamp || (gossipVote.SignedTimestamp < latestRelayTimestamp && !oracleR.isOwnBatch(gossipVote)) {
 				continue
 			}
 
 			votes = append(votes, gossipVote)
 		}
-		oracleR.OracleInfo.GossipVoteBuffer.RUnlock()
+		for _, heartbeat := range oracleR.OracleInfo.State.Heartbeats() {
+			if !sendHeartbeats || heartbeat.SignedTimestamp < latestAllowableTimestamp || (heartbeat.SignedTimestamp < latestRelayTimestamp && !oracleR.isOwnBatch(heartbeat)) {
+				continue
+			}
+			votes = append(votes, heartbeat)
+		}
 		postLockTime := time.Now().UnixMilli()
 		diff := postLockTime - preLockTime
 		if diff > 100 {
 			logrus.Warnf("WARNING!!! Sending gossip lock took %v milliseconds", diff)
 		}
 
-		for _, vote := range votes {
+		fresh, catchup := splitSentVotes(votes, sent)
+		sent = make(map[*oracleproto.GossipedVotes]struct{}, len(votes))
+		for _, vote := range catchup {
+			sent[vote] = struct{}{}
+		}
+
+		sendFailed := false
+		for _, vote := range fresh {
 			success := peer.Send(p2p.Envelope{
 				ChannelID: OracleChannel,
 				Message:   vote,
 			})
 			if !success {
+				oracleR.OracleInfo.Metrics.SendFailures.Add(1)
+				sendFailed = true
 				break
 			}
+			sent[vote] = struct{}{}
+
+			if latency, ok := oracleR.OracleInfo.BatchLatency.MarkSent(vote); ok {
+				oracleR.OracleInfo.Metrics.VoteLatencySeconds.With("stage", "gossip").Observe(latency.Seconds())
+			}
+		}
+
+		if time.Since(lastCatchup) >= catchupInterval {
+			for _, vote := range catchup {
+				if !peer.TrySend(p2p.Envelope{
+					ChannelID: OracleCatchupChannel,
+					Message:   vote,
+				}) {
+					break
+				}
+			}
+			lastCatchup = time.Now()
+		}
+
+		// after a failed send, back off without waking up on new batches, as
+		// the peer's send queue is likely still full
+		if sendFailed {
+			sendFailures++
+			select {
+			case <-time.After(sendBackoff(oracleR.rand, interval, sendFailures)):
+				continue
+			case <-peer.Quit():
+				return
+			case <-oracleR.Quit():
+				return
+			}
+		}
+		sendFailures = 0
+
+		// under consensus stress, wait for the stress interval without waking
+		// up on new batches
+		if stressed {
+			select {
+			case <-time.After(oracleR.OracleInfo.Config.ConsensusStressGossipInterval):
+				continue
+			case <-peer.Quit():
+				return
+			case <-oracleR.Quit():
+				return
+			}
+		}
+
+		// wait for new batches, retrying after the interval if there are
+		// batches left to resend
+		var retry <-chan time.Time
+		if len(catchup) > 0 {
+			retry = time.After(interval)
+		}
+		select {
+		case <-updated:
+		case <-retry:
+		case <-peer.Quit():
+			return
+		case <-oracleR.Quit():
+			return
+		}
+	}
+}
+
+// latestRelayTimestamp returns the signed timestamp before which the batches
+// of other validators are no longer relayed, or zero if they are relayed for
+// as long as they are kept.
+func (oracleR *Reactor) latestRelayTimestamp() int64 {
+	maxRelayAge := oracleR.OracleInfo.Config.MaxOracleRelayAge
+	if maxRelayAge == 0 {
+		return 0
+	}
+	return oracleR.OracleInfo.Now().Unix() - int64(maxRelayAge)
+}
+
+// isOwnBatch returns true if the batch was signed by this node.
+func (oracleR *Reactor) isOwnBatch(batch *oracleproto.GossipedVotes) bool {
+	return bytes.Equal(batch.PubKey, oracleR.OracleInfo.PubKey.Bytes())
+}
+
+// peers returns the peers of the switch the reactor is added to.
+func (oracleR *Reactor) peers() []p2p.Peer {
+	if oracleR.Switch == nil {
+		return nil
+	}
+	return oracleR.Switch.Peers().List()
+}
+
+// peerProtocolVersion returns the oracle protocol version advertised by the
+// peer, or 1 if the peer does not advertise a valid version.
+func peerProtocolVersion(peer p2p.Peer) uint64 {
+	nodeInfo, ok := peer.NodeInfo().(p2p.DefaultNodeInfo)
+	if !ok {
+		return 1
+	}
+	version, err := strconv.ParseUint(nodeInfo.Other.OracleVersion, 10, 64)
+	if err != nil || version == 0 {
+		return 1
+	}
+	return version
+}
+
+// sendBackoff returns how long to wait before sending to a peer again after
+// the given number of consecutive failed sends. The wait doubles from
+// interval up to maxSendBackoff, with jitter so that peers are not all
+// retried at once.
+func sendBackoff(rand *cmtrand.Rand, interval time.Duration, failures int) time.Duration {
+	backoff := maxSendBackoff
+	if shift := failures - 1; shift < 16 && interval<<shift < maxSendBackoff {
+		backoff = interval << shift
+	}
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// splitSentVotes splits votes into those not yet sent to a peer and those
+// that were, according to the set of batches already sent.
+func splitSentVotes(votes []*oracleproto.GossipedVotes, sent map[*oracleproto.GossipedVotes]struct{}) (fresh, catchup []*oracleproto.GossipedVotes) {
+	for _, vote := range votes {
+		if _, ok := sent[vote]; ok {
+			catchup = append(catchup, vote)
+		} else {
+			fresh = append(fresh, vote)
 		}
-		time.Sleep(interval)
 	}
+	return fresh, catchup
 }
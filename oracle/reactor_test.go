@@ -0,0 +1,511 @@
+package oracle
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abcicli "github.com/cometbft/cometbft/abci/client"
+	abciserver "github.com/cometbft/cometbft/abci/server"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/crypto/sr25519"
+	"github.com/cometbft/cometbft/libs/protoio"
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	"github.com/cometbft/cometbft/oracle/service/runner"
+	"github.com/cometbft/cometbft/oracle/service/runner/runnertest"
+	oracletypes "github.com/cometbft/cometbft/oracle/service/types"
+	"github.com/cometbft/cometbft/oracle/verify"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/p2p/conn"
+	"github.com/cometbft/cometbft/p2p/mock"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+	"github.com/cometbft/cometbft/proxy"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestReactorSkipsPeersWithoutOracleChannel(t *testing.T) {
+	pv := types.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	assert.NoError(t, err)
+
+	oracleR := NewReactor(config.TestOracleConfig(), pubKey, pv, nil, nil)
+	skippedPeers := &testCounter{}
+	oracleR.OracleInfo.Metrics.SkippedPeers = skippedPeers
+
+	// mock peers do not advertise any channels
+	peer := mock.NewPeer(net.IP{127, 0, 0, 1})
+	oracleR.AddPeer(peer)
+	assert.EqualValues(t, 1, skippedPeers.value)
+}
+
+func TestPeerProtocolVersion(t *testing.T) {
+	for _, tc := range []struct {
+		advertised string
+		version    uint64
+	}{
+		{"", 1},
+		{"invalid", 1},
+		{"0", 1},
+		{"2", 2},
+		{"3", 3},
+		{"4", 4},
+	} {
+		peer := &versionedPeer{Peer: mock.NewPeer(net.IP{127, 0, 0, 1}), oracleVersion: tc.advertised}
+		assert.Equal(t, tc.version, peerProtocolVersion(peer), tc.advertised)
+	}
+}
+
+func TestReactorRelayAge(t *testing.T) {
+	pv := types.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+
+	cfg := config.TestOracleConfig()
+	oracleR := NewReactor(cfg, pubKey, pv, nil, nil)
+	assert.Zero(t, oracleR.latestRelayTimestamp())
+
+	cfg.MaxOracleRelayAge = 10
+	assert.InDelta(t, time.Now().Unix()-10, oracleR.latestRelayTimestamp(), 1)
+
+	assert.True(t, oracleR.isOwnBatch(&oracleproto.GossipedVotes{PubKey: pubKey.Bytes()}))
+	assert.False(t, oracleR.isOwnBatch(&oracleproto.GossipedVotes{PubKey: types.NewMockPV().PrivKey.PubKey().Bytes()}))
+}
+
+func TestReactorPeerMisbehavior(t *testing.T) {
+	pv := types.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+
+	cfg := config.TestOracleConfig()
+	cfg.MaxPeerMisbehavior = 2
+	oracleR := NewReactor(cfg, pubKey, pv, nil, nil)
+	transport := p2p.NewMultiplexTransport(p2p.DefaultNodeInfo{}, p2p.NodeKey{PrivKey: ed25519.GenPrivKey()}, conn.DefaultMConnConfig())
+	oracleR.SetSwitch(p2p.NewSwitch(config.DefaultP2PConfig(), transport))
+	peer := mock.NewPeer(net.IP{127, 0, 0, 1})
+
+	// the sign type is not supported
+	batch := &oracleproto.GossipedVotes{Signature: []byte{0x00, 0x09}}
+	oracleR.Receive(p2p.Envelope{Src: peer, ChannelID: OracleChannel, Message: batch})
+	reports := oracleR.PeerMisbehavior()
+	require.Len(t, reports, 1)
+	assert.Equal(t, misbehaviorInvalidSigner, reports[0].Reason)
+	assert.False(t, reports[0].Stopped)
+
+	oracleR.Receive(p2p.Envelope{Src: peer, ChannelID: OracleChannel, Message: batch})
+	reports = oracleR.PeerMisbehavior()
+	require.Len(t, reports, 1)
+	assert.Equal(t, 2, reports[0].Count)
+	assert.True(t, reports[0].Stopped)
+	assert.False(t, peer.IsRunning())
+}
+
+func TestReactorReceiveKeyTypes(t *testing.T) {
+	for _, keyType := range []string{ed25519.KeyType, sr25519.KeyType, secp256k1.KeyType} {
+		t.Run(keyType, func(t *testing.T) {
+			signer := runnertest.NewPrivValidatorWithKeyType(keyType, "signer")
+			signerKey, err := signer.GetPubKey()
+			require.NoError(t, err)
+			val := types.NewValidator(signerKey, 10)
+			cs := runnertest.NewConsensusState(time.Now(), val)
+
+			// the batch is signed as by the runner of the signing validator
+			signerInfo := runnertest.NewOracleInfo(config.TestOracleConfig(), signer, runnertest.NewApp())
+			signerInfo.SignVotesChan <- &oracleproto.Vote{OracleId: "oracle", Timestamp: time.Now().Unix(), Data: "1"}
+			runner.ProcessSignVoteQueue(signerInfo, cs)
+			batches := signerInfo.State.CurrentBatches()
+			require.Len(t, batches, 1)
+
+			pv := runnertest.NewPrivValidator("validator")
+			pubKey, err := pv.GetPubKey()
+			require.NoError(t, err)
+			oracleR := NewReactor(config.TestOracleConfig(), pubKey, pv, nil, nil)
+			oracleR.ConsensusState = cs
+			oracleR.Receive(p2p.Envelope{Src: mock.NewPeer(net.IP{127, 0, 0, 1}), ChannelID: OracleChannel, Message: batches[0]})
+
+			// the batch is keyed by the address of the validator, whichever its key type
+			assert.Contains(t, oracleR.OracleInfo.State.Batches(), oracletypes.GossipVoteKey{Address: oracletypes.ValAddressFromBytes(val.Address)})
+			assert.Empty(t, oracleR.PeerMisbehavior())
+		})
+	}
+}
+
+func TestReactorReceiveTamperedVoteData(t *testing.T) {
+	signer := runnertest.NewPrivValidator("signer")
+	signerKey, err := signer.GetPubKey()
+	require.NoError(t, err)
+	cs := runnertest.NewConsensusState(time.Now(), types.NewValidator(signerKey, 10))
+
+	// the data of the vote is gossiped by hash, and is not covered by the signature
+	cfg := config.TestOracleConfig()
+	cfg.MaxInlineDataSize = 1
+	signerInfo := runnertest.NewOracleInfo(cfg, signer, runnertest.NewApp())
+	signerInfo.SignVotesChan <- &oracleproto.Vote{OracleId: "oracle", Timestamp: time.Now().Unix(), Data: "large"}
+	runner.ProcessSignVoteQueue(signerInfo, cs)
+	batches := signerInfo.State.CurrentBatches()
+	require.Len(t, batches, 1)
+	require.NotEmpty(t, batches[0].Votes[0].DataHash)
+
+	// a relaying peer fills in data not matching the hash
+	tampered := *batches[0]
+	vote := *tampered.Votes[0]
+	vote.Data = "tampered"
+	tampered.Votes = []*oracleproto.Vote{&vote}
+
+	pv := runnertest.NewPrivValidator("validator")
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	oracleR := NewReactor(config.TestOracleConfig(), pubKey, pv, nil, nil)
+	oracleR.ConsensusState = cs
+	peer := mock.NewPeer(net.IP{127, 0, 0, 1})
+	oracleR.Receive(p2p.Envelope{Src: peer, ChannelID: OracleChannel, Message: &tampered})
+
+	assert.Empty(t, oracleR.OracleInfo.State.Batches())
+	reports := oracleR.PeerMisbehavior()
+	require.Len(t, reports, 1)
+	assert.Equal(t, misbehaviorInvalidData, reports[0].Reason)
+
+	// the untampered batch is still accepted
+	oracleR.Receive(p2p.Envelope{Src: peer, ChannelID: OracleChannel, Message: batches[0]})
+	assert.Len(t, oracleR.OracleInfo.State.Batches(), 1)
+}
+
+func TestReactorReceiveLegacyBatch(t *testing.T) {
+	signer := ed25519.GenPrivKey()
+	cs := runnertest.NewConsensusState(time.Now(), types.NewValidator(signer.PubKey(), 10))
+
+	// the batch is signed over all of its votes, as by nodes predating the
+	// signing over the merkle root
+	batch := &oracleproto.GossipedVotes{
+		PubKey:          signer.PubKey().Bytes(),
+		SignedTimestamp: time.Now().Unix(),
+		Votes:           []*oracleproto.Vote{{OracleId: "oracle", Timestamp: time.Now().Unix(), Data: "1"}},
+	}
+	bz, err := protoio.MarshalDelimited(&oracleproto.LegacyCanonicalGossipedVotes{
+		PubKey:          batch.PubKey,
+		Votes:           batch.Votes,
+		SignedTimestamp: batch.SignedTimestamp,
+		ChainId:         runnertest.ChainID,
+	})
+	require.NoError(t, err)
+	sig, err := signer.Sign(bz)
+	require.NoError(t, err)
+	batch.Signature = append([]byte{verify.MainAccountSigPrefix, verify.Ed25519SignType}, sig...)
+
+	pv := types.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	oracleR := NewReactor(config.TestOracleConfig(), pubKey, pv, nil, nil)
+	oracleR.ConsensusState = cs
+	legacyBatches := &testCounter{}
+	oracleR.OracleInfo.Metrics.LegacyBatches = legacyBatches
+
+	// the batch is dropped, but the peer, which does not advertise a protocol
+	// version, is not reported for it
+	oracleR.Receive(p2p.Envelope{Src: mock.NewPeer(net.IP{127, 0, 0, 1}), ChannelID: OracleChannel, Message: batch})
+	assert.Empty(t, oracleR.OracleInfo.State.Batches())
+	assert.Empty(t, oracleR.PeerMisbehavior())
+	assert.EqualValues(t, 1, legacyBatches.value)
+
+	// peers advertising a protocol version sign over the merkle root
+	peer := &versionedPeer{Peer: mock.NewPeer(net.IP{127, 0, 0, 2}), oracleVersion: strconv.Itoa(ProtocolVersion)}
+	oracleR.Receive(p2p.Envelope{Src: peer, ChannelID: OracleChannel, Message: batch})
+	reports := oracleR.PeerMisbehavior()
+	require.Len(t, reports, 1)
+	assert.Equal(t, misbehaviorInvalidSignature, reports[0].Reason)
+}
+
+func TestReactorReceiveUnrequestedData(t *testing.T) {
+	pv := types.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	oracleR := NewReactor(config.TestOracleConfig(), pubKey, pv, nil, nil)
+	peer := mock.NewPeer(net.IP{127, 0, 0, 1})
+
+	data := "data"
+	hash := types.OracleVoteDataHash(data)
+	response := &oracleproto.OracleDataResponse{DataHash: hash, Data: data}
+
+	// data that was not requested from the peer is dropped
+	oracleR.Receive(p2p.Envelope{Src: peer, ChannelID: OracleDataChannel, Message: response})
+	assert.False(t, oracleR.OracleInfo.VoteDataStore.Has(hash))
+
+	oracleR.requestMissingData(peer, &oracleproto.GossipedVotes{Votes: []*oracleproto.Vote{{OracleId: "oracle", DataHash: hash}}})
+	oracleR.Receive(p2p.Envelope{Src: peer, ChannelID: OracleDataChannel, Message: response})
+	assert.True(t, oracleR.OracleInfo.VoteDataStore.Has(hash))
+	assert.Empty(t, oracleR.PeerMisbehavior())
+}
+
+func TestReactorReceiveProbe(t *testing.T) {
+	pv := types.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	nodeKey := ed25519.GenPrivKey()
+	oracleR := NewReactor(config.TestOracleConfig(), pubKey, pv, nil, nil, WithNodeKey(nodeKey))
+	oracleR.ConsensusState = runnertest.NewConsensusState(time.Now(), types.NewValidator(pubKey, 10))
+	peer := mock.NewPeer(net.IP{127, 0, 0, 1})
+
+	origin := ed25519.GenPrivKey()
+	probe, err := signProbe(runnertest.ChainID, origin, time.Now().UnixMilli())
+	require.NoError(t, err)
+
+	// a relaying peer tampers with the time the probe was sent at
+	tampered := *probe
+	tampered.SentAt -= 1000
+	oracleR.Receive(p2p.Envelope{Src: peer, ChannelID: OracleDataChannel, Message: &tampered})
+	assert.Empty(t, oracleR.ProbePropagation())
+	reports := oracleR.PeerMisbehavior()
+	require.Len(t, reports, 1)
+	assert.Equal(t, misbehaviorInvalidSignature, reports[0].Reason)
+
+	// probes sent too long ago are dropped
+	stale, err := signProbe(runnertest.ChainID, origin, time.Now().Add(-2*maxProbeAge).UnixMilli())
+	require.NoError(t, err)
+	oracleR.Receive(p2p.Envelope{Src: peer, ChannelID: OracleDataChannel, Message: stale})
+	assert.Empty(t, oracleR.ProbePropagation())
+
+	// as are our own probes relayed back to us
+	own, err := signProbe(runnertest.ChainID, nodeKey, time.Now().UnixMilli())
+	require.NoError(t, err)
+	oracleR.Receive(p2p.Envelope{Src: peer, ChannelID: OracleDataChannel, Message: own})
+	assert.Empty(t, oracleR.ProbePropagation())
+
+	probe.Hops = 2
+	oracleR.Receive(p2p.Envelope{Src: peer, ChannelID: OracleDataChannel, Message: probe})
+	propagation := oracleR.ProbePropagation()
+	require.Len(t, propagation, 1)
+	assert.Equal(t, string(p2p.PubKeyToID(origin.PubKey())), propagation[0].OriginID)
+	assert.Equal(t, 1, propagation[0].Probes)
+	assert.EqualValues(t, 2, propagation[0].LastHops)
+	assert.Len(t, oracleR.PeerMisbehavior(), 1)
+}
+
+func TestSplitSentVotes(t *testing.T) {
+	a, b, c := &oracleproto.GossipedVotes{}, &oracleproto.GossipedVotes{}, &oracleproto.GossipedVotes{}
+	sent := map[*oracleproto.GossipedVotes]struct{}{b: {}}
+
+	fresh, catchup := splitSentVotes([]*oracleproto.GossipedVotes{a, b, c}, sent)
+	assert.Equal(t, []*oracleproto.GossipedVotes{a, c}, fresh)
+	assert.Equal(t, []*oracleproto.GossipedVotes{b}, catchup)
+}
+
+func TestSendBackoff(t *testing.T) {
+	rand := cmtrand.NewRand()
+	interval := 250 * time.Millisecond
+	testCases := []struct {
+		failures int
+		max      time.Duration
+	}{
+		{1, interval},
+		{2, 2 * interval},
+		{3, 4 * interval},
+		{10, maxSendBackoff},
+		{100, maxSendBackoff},
+	}
+
+	for _, tc := range testCases {
+		for i := 0; i < 10; i++ {
+			backoff := sendBackoff(rand, interval, tc.failures)
+			assert.GreaterOrEqual(t, backoff, tc.max/2, "failures %d", tc.failures)
+			assert.LessOrEqual(t, backoff, tc.max, "failures %d", tc.failures)
+		}
+	}
+
+	// the jitter is the same with the same seed
+	a, b := cmtrand.NewRand(), cmtrand.NewRand()
+	a.Seed(1)
+	b.Seed(1)
+	for failures := 1; failures < 10; failures++ {
+		assert.Equal(t, sendBackoff(a, interval, failures), sendBackoff(b, interval, failures))
+	}
+}
+
+func TestReactorPrunesBatchesFromRemovedValidators(t *testing.T) {
+	pv := types.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	assert.NoError(t, err)
+
+	vals := makeValidators(2)
+	cs := runnertest.NewConsensusState(time.Now(), vals...)
+	oracleR := NewReactor(config.TestOracleConfig(), pubKey, pv, nil, nil)
+	oracleR.ConsensusState = cs
+
+	mainSig := append([]byte{}, oracletypes.MainAccountSigPrefix...)
+	mainSig = append(mainSig, oracletypes.Ed25519SignType...)
+	subSig := append([]byte{}, oracletypes.SubAccountSigPrefix...)
+	subSig = append(subSig, oracletypes.Ed25519SignType...)
+
+	rotatedKey := oracletypes.GossipVoteKey{Address: oracletypes.ValAddressFromBytes(vals[0].Address)}
+	currentKey := oracletypes.GossipVoteKey{Address: oracletypes.ValAddressFromBytes(vals[1].Address)}
+	subAccountKey := oracletypes.GossipVoteKey{Address: oracletypes.ValAddress{0x01}}
+	for _, key := range []oracletypes.GossipVoteKey{rotatedKey, currentKey} {
+		oracleR.OracleInfo.State.MergeGossip(key.Address, &oracleproto.GossipedVotes{Signature: mainSig})
+	}
+	oracleR.OracleInfo.State.MergeGossip(subAccountKey.Address, &oracleproto.GossipedVotes{Signature: subSig})
+
+	oracleR.updateValidatorIndex()
+	assert.Len(t, oracleR.OracleInfo.State.Batches(), 3)
+
+	// the first validator rotates to a new key
+	cs.Commit(2, time.Now())
+	cs.SetValidators(append(makeValidators(1), vals[1])...)
+	oracleR.updateValidatorIndex()
+
+	buffer := oracleR.OracleInfo.State.Batches()
+	assert.NotContains(t, buffer, rotatedKey)
+	assert.Contains(t, buffer, currentKey)
+	assert.Contains(t, buffer, subAccountKey)
+}
+
+func TestReactorVoteProof(t *testing.T) {
+	cfg := config.TestOracleConfig()
+	cfg.MaxVotesPerBatch = 2
+	pv := runnertest.NewPrivValidator("validator")
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	oracleR := NewReactor(cfg, pubKey, pv, runnertest.NewApp(), nil)
+	cs := runnertest.NewConsensusState(time.Now())
+
+	for i := 0; i < 5; i++ {
+		oracleR.OracleInfo.SignVotesChan <- &oracleproto.Vote{OracleId: fmt.Sprintf("oracle-%d", i), Timestamp: 10, Data: "1"}
+	}
+	runner.ProcessSignVoteQueue(oracleR.OracleInfo, cs)
+
+	// the vote is in the last of the batches
+	proof, err := oracleR.VoteProof(pubKey.Address(), "oracle-4", 10)
+	require.NoError(t, err)
+	assert.Equal(t, "oracle-4", proof.Vote.OracleId)
+	assert.EqualValues(t, 2, proof.BatchSeq)
+	require.NoError(t, proof.Verify(runnertest.ChainID, pubKey))
+
+	_, err = oracleR.VoteProof(pubKey.Address(), "oracle-4", 11)
+	assert.Error(t, err)
+	_, err = oracleR.VoteProof(types.NewMockPV().PrivKey.PubKey().Address(), "oracle-4", 10)
+	assert.Error(t, err)
+}
+
+func TestReactorRelayOnlyDoesNotFetchVotes(t *testing.T) {
+	pv := types.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	assert.NoError(t, err)
+
+	cfg := config.TestOracleConfig()
+	cfg.RelayOnly = true
+	app := &testApp{}
+	oracleR := NewReactor(cfg, pubKey, pv, app, nil)
+	oracleR.ConsensusState = runnertest.NewConsensusState(time.Now())
+
+	assert.NoError(t, oracleR.Start())
+	time.Sleep(50 * time.Millisecond)
+	assert.NoError(t, oracleR.Stop())
+
+	assert.EqualValues(t, 0, app.fetches.Load())
+}
+
+func TestReactorCustomRunner(t *testing.T) {
+	pv := types.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+
+	started := make(chan *oracletypes.OracleInfo, 1)
+	stopped := make(chan struct{})
+	app := &testApp{}
+	oracleR := NewReactor(config.TestOracleConfig(), pubKey, pv, app, nil, WithRunner(func(ctx context.Context, oracleInfo *oracletypes.OracleInfo, _ runner.ConsensusState) {
+		started <- oracleInfo
+		<-ctx.Done()
+		close(stopped)
+	}))
+	oracleR.ConsensusState = runnertest.NewConsensusState(time.Now())
+
+	require.NoError(t, oracleR.Start())
+	select {
+	case oracleInfo := <-started:
+		assert.Same(t, oracleR.OracleInfo, oracleInfo)
+	case <-time.After(time.Second):
+		t.Fatal("custom runner was not started")
+	}
+	require.NoError(t, oracleR.Stop())
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("custom runner was not stopped")
+	}
+
+	assert.EqualValues(t, 0, app.fetches.Load())
+}
+
+func TestReactorFetchesVotesFromFeeder(t *testing.T) {
+	pv := types.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+
+	addr := "unix://" + filepath.Join(t.TempDir(), "feeder.sock")
+	feeder := abciserver.NewGRPCServer(addr, &feederApp{})
+	require.NoError(t, feeder.Start())
+	t.Cleanup(func() {
+		if err := feeder.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	oracleR := NewReactor(config.TestOracleConfig(), pubKey, pv, runnertest.NewApp(), nil, WithFeeder(abcicli.NewGRPCClient(addr, false)))
+	oracleR.ConsensusState = runnertest.NewConsensusState(time.Now())
+
+	require.NoError(t, oracleR.Start())
+	assert.Eventually(t, func() bool {
+		votes := oracleR.OracleInfo.State.UnsignedVotes()
+		return len(votes) > 0 && votes[0].OracleId == "feeder"
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, oracleR.Stop())
+}
+
+// feederApp serves votes to sign.
+type feederApp struct {
+	abci.BaseApplication
+}
+
+func (feederApp) FetchOracleVotes(context.Context, *abci.RequestFetchOracleVotes) (*abci.ResponseFetchOracleVotes, error) {
+	return &abci.ResponseFetchOracleVotes{Vote: &oracleproto.Vote{OracleId: "feeder", Timestamp: time.Now().Unix(), Data: "1"}}, nil
+}
+
+// testApp counts the oracle votes fetched from it.
+type testApp struct {
+	proxy.AppConnConsensus
+	fetches atomic.Int32
+}
+
+func (app *testApp) FetchOracleVotes(context.Context, *abci.RequestFetchOracleVotes) (*abci.ResponseFetchOracleVotes, error) {
+	app.fetches.Add(1)
+	return &abci.ResponseFetchOracleVotes{}, nil
+}
+
+// testCounter is a metrics.Counter recording the total added to it.
+type testCounter struct {
+	value float64
+}
+
+func (c *testCounter) With(...string) metrics.Counter { return c }
+func (c *testCounter) Add(delta float64)              { c.value += delta }
+
+// versionedPeer is a peer advertising the given oracle protocol version.
+type versionedPeer struct {
+	*mock.Peer
+	oracleVersion string
+}
+
+func (p *versionedPeer) NodeInfo() p2p.NodeInfo {
+	nodeInfo := p.Peer.NodeInfo().(p2p.DefaultNodeInfo)
+	nodeInfo.Other.OracleVersion = p.oracleVersion
+	return nodeInfo
+}
@@ -2,7 +2,7 @@ package runner
 
 import (
 	"context"
-	"fmt"
+	"math"
 	"sort"
 
 	"time"
@@ -13,28 +13,38 @@ import (
 	"github.com/cometbft/cometbft/oracle/service/utils"
 
 	abcitypes "github.com/cometbft/cometbft/abci/types"
-	cs "github.com/cometbft/cometbft/consensus"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/mempool"
 	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+	sm "github.com/cometbft/cometbft/state"
+	cmttypes "github.com/cometbft/cometbft/types"
 )
 
-func RunProcessSignVoteQueue(oracleInfo *types.OracleInfo, consensusState *cs.State) {
+// ConsensusState is the subset of the consensus state used by the oracle,
+// satisfied by *consensus.State.
+type ConsensusState interface {
+	GetState() sm.State
+	GetLastHeight() int64
+	GetValidators() (int64, []*cmttypes.Validator)
+}
+
+func RunProcessSignVoteQueue(ctx context.Context, oracleInfo *types.OracleInfo, consensusState ConsensusState) {
 	// sign votes every x milliseconds, where x = Config.SignInterval
 	interval := oracleInfo.Config.SignInterval
 
-	go func(oracleInfo *types.OracleInfo) {
+	go supervise(ctx, oracleInfo, "sign", func() {
 		for {
 			select {
-			case <-oracleInfo.StopChannel:
+			case <-ctx.Done():
 				return
-			default:
-				time.Sleep(interval)
+			case <-time.After(interval):
 				ProcessSignVoteQueue(oracleInfo, consensusState)
 			}
 		}
-	}(oracleInfo)
+	})
 }
 
-func ProcessSignVoteQueue(oracleInfo *types.OracleInfo, consensusState *cs.State) {
+func ProcessSignVoteQueue(oracleInfo *types.OracleInfo, consensusState ConsensusState) {
 	votes := []*oracleproto.Vote{}
 
 	for {
@@ -47,28 +57,53 @@ func ProcessSignVoteQueue(oracleInfo *types.OracleInfo, consensusState *cs.State
 		break
 	}
 
-	if len(votes) == 0 {
+	// the first votes fetched after starting may be based on partial data,
+	// they are dropped until the warm-up period is over
+	if oracleInfo.WarmingUp() {
+		if len(votes) > 0 {
+			log.Debugf("processSignVoteQueue: warming up, dropped %v votes", len(votes))
+		}
 		return
 	}
 
-	// batch sign the new votes, along with existing votes in gossipVoteBuffer, if any
-	// append new batch into unsignedVotesBuffer, need to mutex lock as it will clash with concurrent pruning
-	oracleInfo.UnsignedVoteBuffer.Lock()
-	oracleInfo.UnsignedVoteBuffer.Buffer = append(oracleInfo.UnsignedVoteBuffer.Buffer, votes...)
+	// votes queued while signing was paused or the node was catching up are
+	// signed once possible, even if no new votes were fetched
+	if len(votes) == 0 && !hasUnsignedBacklog(oracleInfo) {
+		return
+	}
 
-	unsignedVotes := []*oracleproto.Vote{}
-	unsignedVotes = append(unsignedVotes, oracleInfo.UnsignedVoteBuffer.Buffer...)
+	// batch sign the new votes, along with existing unsigned votes, if any
+	unsignedVotes, duplicates, overflow := oracleInfo.State.AddUnsigned(votes...)
+	if duplicates > 0 {
+		oracleInfo.Metrics.DuplicateVotes.Add(float64(duplicates))
+	}
+	for oracleID, dropped := range overflow {
+		log.Warnf("processSignVoteQueue: dropped %v votes of oracle %v, which already has %v votes waiting to be signed", dropped, oracleID, oracleInfo.Config.MaxUnsignedVotesPerOracle)
+		oracleInfo.Metrics.OverflowVotes.With("oracle_id", oracleID).Add(float64(dropped))
+	}
 
-	oracleInfo.UnsignedVoteBuffer.Unlock()
+	// keep the votes for when signing is resumed, our previous batches are
+	// still gossiped until they are pruned
+	if oracleInfo.SigningPaused() {
+		return
+	}
+
+	// keep the votes until the node has caught up, as the chain the votes are
+	// signed for may not be known yet
+	chainID, ok := signingChainID(oracleInfo, consensusState)
+	if !ok {
+		log.Debugf("processSignVoteQueue: node is catching up, queued %v votes without signing", len(unsignedVotes))
+		return
+	}
 
 	// sort the votes so that we can rebuild it in a deterministic order, when uncompressing
 	SortOracleVotes(unsignedVotes)
 
-	// batch sign the entire unsignedVoteBuffer and add to gossipBuffer
-	newGossipVote := &oracleproto.GossipedVotes{
-		PubKey:          oracleInfo.PubKey.Bytes(),
-		SignedTimestamp: time.Now().Unix(),
-		Votes:           unsignedVotes,
+	// replace data too large to be gossiped inline by its hash, peers fetch the data on demand
+	if oracleInfo.Config.MaxInlineDataSize > 0 {
+		var oversized []*oracleproto.Vote
+		unsignedVotes, oversized = HashLargeVoteData(unsignedVotes, oracleInfo.Config.MaxInlineDataSize, oracleInfo.Config.MaxVoteDataSize(), oracleInfo.VoteDataStore)
+		dropOversizedVotes(oracleInfo, oversized)
 	}
 
 	// set sigPrefix based on account type and sign type
@@ -78,27 +113,244 @@ func ProcessSignVoteQueue(oracleInfo *types.OracleInfo, consensusState *cs.State
 		return
 	}
 
-	// signing of vote should append the signature field of gossipVote
-	if err := oracleInfo.PrivValidator.SignOracleVote(consensusState.GetState().ChainID, newGossipVote, sigPrefix); err != nil {
-		log.Errorf("processSignVoteQueue: error signing oracle votes: %v", err)
+	// batch sign the entire unsignedVoteBuffer and add to gossipBuffer, split into as many batches as
+	// needed to keep each batch within the max votes and max gossip msg size
+	signedTimestamp := oracleInfo.Now().Unix()
+	metadata := signerMetadata(oracleInfo.Config)
+	maxBytes := oracleInfo.Config.MaxGossipMsgSize - (&oracleproto.GossipedVotes{SignerMetadata: metadata}).Size()
+	batches, oversized := SplitOracleVotes(oracleInfo.PubKey.Bytes(), unsignedVotes, oracleInfo.Config.MaxVotesPerBatch, maxBytes)
+	dropOversizedVotes(oracleInfo, oversized)
+	if len(batches) == 0 {
 		return
 	}
+	newGossipVotes := make([]*oracleproto.GossipedVotes, 0, len(batches))
+	for seq, batch := range batches {
+		newGossipVote := &oracleproto.GossipedVotes{
+			PubKey:          oracleInfo.PubKey.Bytes(),
+			SignedTimestamp: signedTimestamp,
+			Votes:           batch,
+			BatchSeq:        uint32(seq),
+			SignerMetadata:  metadata,
+		}
+
+		// signing of vote should append the signature field of gossipVote
+		signStart := time.Now()
+		if err := oracleInfo.PrivValidator.SignOracleVote(chainID, newGossipVote, sigPrefix); err != nil {
+			log.Errorf("processSignVoteQueue: error signing oracle votes: %v", err)
+			return
+		}
+		oracleInfo.Metrics.VoteLatencySeconds.With("stage", "sign").Observe(time.Since(signStart).Seconds())
 
-	// need to mutex lock as it will clash with concurrent gossip
+		newGossipVotes = append(newGossipVotes, newGossipVote)
+	}
+
+	// replace all of our previous batches, as the new batches cover all unsigned votes
 	preLockTime := time.Now().UnixMilli()
-	oracleInfo.GossipVoteBuffer.Lock()
-	address := oracleInfo.PubKey.Address().String()
-	oracleInfo.GossipVoteBuffer.Buffer[address] = newGossipVote
-	oracleInfo.GossipVoteBuffer.Unlock()
+	oracleInfo.State.SealBatch(types.ValAddressFromPubKey(oracleInfo.PubKey), newGossipVotes)
+	oracleInfo.BatchLatency.Signed(newGossipVotes[0], time.Now())
 	postLockTime := time.Now().UnixMilli()
 	diff := postLockTime - preLockTime
 	if diff > 100 {
 		log.Warnf("WARNING!!! Updating gossip lock took %v milliseconds", diff)
 	}
+
+	if oracleInfo.Config.TxFallbackRoute != "" {
+		for _, newGossipVote := range newGossipVotes {
+			SubmitGossipVoteTx(oracleInfo, newGossipVote)
+		}
+	}
+}
+
+// RunHeartbeat publishes a heartbeat every Config.HeartbeatInterval, unless
+// heartbeats are disabled.
+func RunHeartbeat(ctx context.Context, oracleInfo *types.OracleInfo, consensusState ConsensusState) {
+	interval := oracleInfo.Config.HeartbeatInterval
+	if interval <= 0 {
+		return
+	}
+
+	go supervise(ctx, oracleInfo, "heartbeat", func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				PublishHeartbeat(oracleInfo, consensusState)
+			}
+		}
+	})
+}
+
+// PublishHeartbeat signs a batch without votes and adds it to the heartbeats
+// gossiped to peers, if there are no votes waiting to be signed. This lets
+// peers tell a validator with nothing to vote on from one that is offline.
+func PublishHeartbeat(oracleInfo *types.OracleInfo, consensusState ConsensusState) {
+	if len(oracleInfo.State.UnsignedVotes()) > 0 || oracleInfo.SigningPaused() {
+		return
+	}
+
+	chainID, ok := signingChainID(oracleInfo, consensusState)
+	if !ok {
+		return
+	}
+
+	sigPrefix, err := utils.FormSignaturePrefix(oracleInfo.Config.EnableSubAccountSigning, oracleInfo.PubKey.Type())
+	if err != nil {
+		log.Errorf("publishHeartbeat: unable to form sig prefix: %v", err)
+		return
+	}
+
+	heartbeat := &oracleproto.GossipedVotes{
+		PubKey:          oracleInfo.PubKey.Bytes(),
+		SignedTimestamp: oracleInfo.Now().Unix(),
+		SignerMetadata:  signerMetadata(oracleInfo.Config),
+	}
+	if err := oracleInfo.PrivValidator.SignOracleVote(chainID, heartbeat, sigPrefix); err != nil {
+		log.Errorf("publishHeartbeat: error signing heartbeat: %v", err)
+		return
+	}
+
+	oracleInfo.State.MergeHeartbeat(types.ValAddressFromPubKey(oracleInfo.PubKey), heartbeat)
+}
+
+// signerMetadata returns the informational metadata set on our batches, or
+// nil if none is configured.
+func signerMetadata(cfg *config.OracleConfig) *oracleproto.SignerMetadata {
+	if cfg.SignerMoniker == "" && cfg.SignerOperator == "" {
+		return nil
+	}
+	return &oracleproto.SignerMetadata{
+		Moniker:  cfg.SignerMoniker,
+		Operator: cfg.SignerOperator,
+	}
+}
+
+// hasUnsignedBacklog returns true if there are votes waiting to be signed but
+// none of our batches, which happens when the votes could not be signed when
+// they were fetched.
+func hasUnsignedBacklog(oracleInfo *types.OracleInfo) bool {
+	if len(oracleInfo.State.UnsignedVotes()) == 0 {
+		return false
+	}
+	_, ok := oracleInfo.State.Batch(types.GossipVoteKey{Address: types.ValAddressFromPubKey(oracleInfo.PubKey)})
+	return !ok
+}
+
+// signingChainID returns the chain ID votes are signed for, or false while the
+// node is catching up or its consensus state is not available.
+func signingChainID(oracleInfo *types.OracleInfo, consensusState ConsensusState) (string, bool) {
+	if consensusState == nil || oracleInfo.CatchingUp() {
+		return "", false
+	}
+	chainID := consensusState.GetState().ChainID
+	return chainID, chainID != ""
+}
+
+// HashLargeVoteData returns the votes with data larger than maxInlineSize
+// replaced by its hash, storing the data in the store. Votes with data larger
+// than maxDataSize, which peers could not fetch, are left out and returned
+// separately. Votes are copied rather than modified.
+func HashLargeVoteData(votes []*oracleproto.Vote, maxInlineSize int, maxDataSize int, store *types.VoteDataStore) ([]*oracleproto.Vote, []*oracleproto.Vote) {
+	hashed := make([]*oracleproto.Vote, 0, len(votes))
+	oversized := []*oracleproto.Vote{}
+	for _, vote := range votes {
+		if len(vote.Data) <= maxInlineSize {
+			hashed = append(hashed, vote)
+			continue
+		}
+		if len(vote.Data) > maxDataSize {
+			oversized = append(oversized, vote)
+			continue
+		}
+		v := *vote
+		v.DataHash = store.Add(vote.Data)
+		v.Data = ""
+		hashed = append(hashed, &v)
+	}
+	return hashed, oversized
 }
 
-func PruneVoteBuffers(oracleInfo *types.OracleInfo, consensusState *cs.State) {
-	go func(oracleInfo *types.OracleInfo) {
+// maxSignatureSize is the size of the largest supported signature, including
+// the account and sign type prefix bytes.
+const maxSignatureSize = 2 + 64
+
+// SplitOracleVotes splits the votes into batches of at most maxVotes votes,
+// each of which encodes to at most maxBytes once signed. Votes too large to
+// fit within maxBytes in a batch of their own are left out and returned
+// separately.
+func SplitOracleVotes(pubKey []byte, votes []*oracleproto.Vote, maxVotes int, maxBytes int) ([][]*oracleproto.Vote, []*oracleproto.Vote) {
+	baseSize := (&oracleproto.GossipedVotes{
+		PubKey:          pubKey,
+		SignedTimestamp: math.MaxInt64,
+		Signature:       make([]byte, maxSignatureSize),
+		BatchSeq:        math.MaxUint32,
+	}).Size()
+
+	batches := [][]*oracleproto.Vote{}
+	oversized := []*oracleproto.Vote{}
+	batch := []*oracleproto.Vote{}
+	batchSize := baseSize
+	for _, vote := range votes {
+		// size of the vote including its field tag and length prefix
+		voteSize := (&oracleproto.GossipedVotes{Votes: []*oracleproto.Vote{vote}}).Size()
+		if baseSize+voteSize > maxBytes {
+			oversized = append(oversized, vote)
+			continue
+		}
+
+		if len(batch) > 0 && (len(batch) >= maxVotes || batchSize+voteSize > maxBytes) {
+			batches = append(batches, batch)
+			batch = []*oracleproto.Vote{}
+			batchSize = baseSize
+		}
+		batch = append(batch, vote)
+		batchSize += voteSize
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches, oversized
+}
+
+// dropOversizedVotes removes the votes too large to be gossiped from the
+// votes waiting to be signed, so that they are dropped once rather than on
+// every signing.
+func dropOversizedVotes(oracleInfo *types.OracleInfo, votes []*oracleproto.Vote) {
+	for _, vote := range votes {
+		log.Warnf("processSignVoteQueue: dropped vote for oracle %v, which is too large to be gossiped", vote.OracleId)
+		oracleInfo.Metrics.OversizedVotes.With("oracle_id", vote.OracleId).Add(1)
+	}
+	oracleInfo.State.RemoveUnsigned(votes...)
+}
+
+// SubmitGossipVoteTx submits the signed batch of votes to the local mempool,
+// so that it reaches the app on networks where peers do not run the oracle
+// reactor.
+func SubmitGossipVoteTx(oracleInfo *types.OracleInfo, gossipVote *oracleproto.GossipedVotes) {
+	if oracleInfo.Mempool == nil {
+		log.Warnf("SubmitGossipVoteTx: tx fallback route is set but no mempool is available")
+		return
+	}
+
+	// like in the oracle result tx, the data of votes gossiped by data hash is filled in, as our
+	// own data is never evicted from the store, and the unsigned metadata is left out of the tx
+	filled := oracleInfo.VoteDataStore.FillData([]*oracleproto.GossipedVotes{gossipVote})
+	tx, err := utils.FormGossipedVotesTx(oracleInfo.Config.TxFallbackRoute, types.WithoutUnsignedMetadata(filled)[0])
+	if err != nil {
+		log.Errorf("SubmitGossipVoteTx: unable to form tx: %v", err)
+		return
+	}
+
+	if err := oracleInfo.Mempool.CheckTx(tx, nil, mempool.TxInfo{}); err != nil {
+		log.Warnf("SubmitGossipVoteTx: unable to submit oracle votes tx to mempool: %v", err)
+	}
+}
+
+func PruneVoteBuffers(ctx context.Context, oracleInfo *types.OracleInfo, consensusState ConsensusState) {
+	go supervise(ctx, oracleInfo, "prune", func() {
 		// only keep votes that are less than x blocks old, where x = Config.MaxOracleGossipBlocksDelayed
 		maxOracleGossipBlocksDelayed := oracleInfo.Config.MaxOracleGossipBlocksDelayed
 		// only keep votes that are less than x seconds old, where x = Config.MaxOracleGossipAge
@@ -106,102 +358,125 @@ func PruneVoteBuffers(oracleInfo *types.OracleInfo, consensusState *cs.State) {
 		// run pruner every x milliseconds, where x = Config.PruneInterval
 		pruneInterval := oracleInfo.Config.PruneInterval
 
-		ticker := time.Tick(pruneInterval)
-		for range ticker {
-			lastBlockTime := consensusState.GetState().LastBlockTime.Unix()
-			currTimestampsLen := len(oracleInfo.BlockTimestamps)
-
-			if currTimestampsLen == 0 {
-				oracleInfo.BlockTimestamps = append(oracleInfo.BlockTimestamps, lastBlockTime)
-				continue
-			}
-
-			if oracleInfo.BlockTimestamps[currTimestampsLen-1] != lastBlockTime {
-				oracleInfo.BlockTimestamps = append(oracleInfo.BlockTimestamps, lastBlockTime)
+		ticker := time.NewTicker(pruneInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
 			}
 
 			// only keep last x number of block timestamps, where x = maxOracleGossipBlocksDelayed
-			if len(oracleInfo.BlockTimestamps) > maxOracleGossipBlocksDelayed {
-				oracleInfo.BlockTimestamps = oracleInfo.BlockTimestamps[1:]
+			if consensusState != nil {
+				lastBlockTime := consensusState.GetState().LastBlockTime.Unix()
+				oracleInfo.State.RecordBlockTimestamp(lastBlockTime, maxOracleGossipBlocksDelayed)
 			}
 
-			latestAllowableTimestamp := time.Now().Unix() - int64(maxOracleGossipAge)
 			// prune votes that are older than the latestAllowableTimestamp, which is the max(earliest block timestamp collected, current time - maxOracleGossipAge)
-			if len(oracleInfo.BlockTimestamps) == maxOracleGossipBlocksDelayed && oracleInfo.BlockTimestamps[0] > latestAllowableTimestamp {
-				latestAllowableTimestamp = oracleInfo.BlockTimestamps[0]
-			}
+			// also prune votes for a given oracle id and timestamp, that have already been committed as results on chain
+			// and batches that have been superseded by a more recently signed batch from the same validator
+			latestAllowableTimestamp := oracleInfo.State.LatestAllowableTimestamp(oracleInfo.Now().Unix(), maxOracleGossipAge, maxOracleGossipBlocksDelayed)
 
-			oracleInfo.UnsignedVoteBuffer.Lock()
-			newVotes := []*oracleproto.Vote{}
-			unsignedVoteBuffer := oracleInfo.UnsignedVoteBuffer.Buffer
-			visitedVoteMap := make(map[string]struct{})
-			for _, vote := range unsignedVoteBuffer {
-				// check for dup votes
-				key := fmt.Sprintf("%v:%v", vote.Timestamp, vote.OracleId)
-				_, exists := visitedVoteMap[key]
-				if exists {
-					continue
+			preLockTime := time.Now().UnixMilli()
+			oracleInfo.State.Prune(latestAllowableTimestamp, func(key string) bool {
+				queryCtx, cancel := types.WithTimeout(ctx, oracleInfo.Config.AppQueryTimeout)
+				defer cancel()
+				res, err := oracleInfo.ProxyApp.DoesOracleResultExist(queryCtx, &abcitypes.RequestDoesOracleResultExist{Key: key})
+				if err != nil {
+					log.Warnf("PruneVoteBuffers: unable to check if oracle result exist for vote: %v: %v", key, err)
+					return false
 				}
+				return res.DoesExist
+			})
+			postLockTime := time.Now().UnixMilli()
+			diff := postLockTime - preLockTime
+			if diff > 100 {
+				log.Warnf("WARNING!!! Pruning took %v milliseconds", diff)
+			}
 
-				visitedVoteMap[key] = struct{}{}
+			oracleInfo.Metrics.GossipBufferBytes.Set(float64(oracleInfo.State.GossipBytes()))
 
-				// also prune votes for a given oracle id and timestamp, that have already been committed as results on chain
-				res, err := oracleInfo.ProxyApp.DoesOracleResultExist(context.Background(), &abcitypes.RequestDoesOracleResultExist{Key: key})
-				if err != nil {
-					log.Warnf("PruneVoteBuffers: unable to check if oracle result exist for vote: %v: %v", vote, err)
-				}
+			// data of our own votes is re-added every time they are signed
+			oracleInfo.VoteDataStore.Prune(oracleInfo.Now().Add(-time.Duration(maxOracleGossipAge) * time.Second))
+			oracleInfo.Metrics.VoteDataBytes.Set(float64(oracleInfo.VoteDataStore.Bytes()))
+		}
+	})
+}
 
-				if res.DoesExist {
-					continue
-				}
+// Run run oracles, until ctx is canceled
+func Run(ctx context.Context, oracleInfo *types.OracleInfo, consensusState ConsensusState) {
+	RunProcessSignVoteQueue(ctx, oracleInfo, consensusState)
+	PruneVoteBuffers(ctx, oracleInfo, consensusState)
+	RunHeartbeat(ctx, oracleInfo, consensusState)
+	// start to take votes from app
+	supervise(ctx, oracleInfo, "fetch", func() {
+		for ctx.Err() == nil {
+			if !waitForSignQueue(ctx, oracleInfo) {
+				return
+			}
 
-				if vote.Timestamp >= latestAllowableTimestamp {
-					newVotes = append(newVotes, vote)
+			fetchStart := time.Now()
+			res, err := fetchOracleVote(ctx, oracleInfo)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
 				}
+				log.Errorf("app not ready: %v, retrying...", err)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(1 * time.Second):
+				}
+				continue
 			}
-			oracleInfo.UnsignedVoteBuffer.Buffer = newVotes
-			oracleInfo.UnsignedVoteBuffer.Unlock()
 
-			preLockTime := time.Now().UnixMilli()
-			oracleInfo.GossipVoteBuffer.Lock()
-			gossipBuffer := oracleInfo.GossipVoteBuffer.Buffer
-
-			// prune gossipedVotes that are older than the latestAllowableTimestamp, which is the max(earliest block timestamp collected, current time - maxOracleGossipAge)
-			for valAddr, gossipVote := range gossipBuffer {
-				if gossipVote.SignedTimestamp < latestAllowableTimestamp {
-					delete(gossipBuffer, valAddr)
-				}
+			if res.Vote == nil {
+				continue
 			}
-			oracleInfo.GossipVoteBuffer.Buffer = gossipBuffer
-			oracleInfo.GossipVoteBuffer.Unlock()
-			postLockTime := time.Now().UnixMilli()
-			diff := postLockTime - preLockTime
-			if diff > 100 {
-				log.Warnf("WARNING!!! Pruning gossip lock took %v milliseconds", diff)
+			oracleInfo.Metrics.VoteLatencySeconds.With("stage", "fetch").Observe(time.Since(fetchStart).Seconds())
+
+			select {
+			case oracleInfo.SignVotesChan <- res.Vote:
+			case <-ctx.Done():
+				return
 			}
 		}
-	}(oracleInfo)
+	})
 }
 
-// Run run oracles
-func Run(oracleInfo *types.OracleInfo, consensusState *cs.State) {
-	RunProcessSignVoteQueue(oracleInfo, consensusState)
-	PruneVoteBuffers(oracleInfo, consensusState)
-	// start to take votes from app
-	for {
-		res, err := oracleInfo.ProxyApp.FetchOracleVotes(context.Background(), &abcitypes.RequestFetchOracleVotes{})
-		if err != nil {
-			log.Errorf("app not ready: %v, retrying...", err)
-			time.Sleep(1 * time.Second)
-			continue
-		}
+// waitForSignQueue waits, once the number of votes waiting to be signed
+// reaches Config.SignQueueHighWatermark, until half of them are signed, so
+// that votes are not fetched faster than they are signed. It returns false
+// if ctx is canceled while waiting.
+func waitForSignQueue(ctx context.Context, oracleInfo *types.OracleInfo) bool {
+	depth := len(oracleInfo.SignVotesChan)
+	oracleInfo.Metrics.SignQueueDepth.Set(float64(depth))
+
+	highWatermark := oracleInfo.Config.SignQueueHighWatermark
+	if highWatermark == 0 || depth < highWatermark {
+		return true
+	}
 
-		if res.Vote == nil {
-			continue
+	log.Debugf("fetch: %v votes waiting to be signed, pausing fetching votes", depth)
+	oracleInfo.Metrics.FetchThrottles.Add(1)
+	for depth > highWatermark/2 {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(oracleInfo.Config.SignInterval):
 		}
-
-		oracleInfo.SignVotesChan <- res.Vote
+		depth = len(oracleInfo.SignVotesChan)
+		oracleInfo.Metrics.SignQueueDepth.Set(float64(depth))
 	}
+	return true
+}
+
+// fetchOracleVote fetches a vote to sign, within Config.FetchTimeout.
+func fetchOracleVote(ctx context.Context, oracleInfo *types.OracleInfo) (*abcitypes.ResponseFetchOracleVotes, error) {
+	ctx, cancel := types.WithTimeout(ctx, oracleInfo.Config.FetchTimeout)
+	defer cancel()
+	return oracleInfo.VoteSource.FetchOracleVotes(ctx, &abcitypes.RequestFetchOracleVotes{})
 }
 
 func SortOracleVotes(votes []*oracleproto.Vote) {
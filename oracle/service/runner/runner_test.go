@@ -0,0 +1,485 @@
+package runner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/oracle/service/runner/runnertest"
+	"github.com/cometbft/cometbft/oracle/service/types"
+	"github.com/cometbft/cometbft/oracle/service/utils"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+func makeVotes(n int) []*oracleproto.Vote {
+	votes := make([]*oracleproto.Vote, n)
+	for i := 0; i < n; i++ {
+		votes[i] = &oracleproto.Vote{
+			Validator: "validator",
+			OracleId:  fmt.Sprintf("oracle-%d", i),
+			Timestamp: 1700000000,
+			Data:      "42000.00",
+		}
+	}
+	return votes
+}
+
+func TestSplitOracleVotes(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey().Bytes()
+
+	testCases := []struct {
+		name        string
+		numVotes    int
+		maxVotes    int
+		maxBytes    int
+		wantBatches int
+	}{
+		{"no votes", 0, 10, 65536, 0},
+		{"fits in one batch", 10, 10, 65536, 1},
+		{"split by max votes", 25, 10, 65536, 3},
+		{"split by max bytes", 25, 100, 500, 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			votes := makeVotes(tc.numVotes)
+			batches, oversized := SplitOracleVotes(pubKey, votes, tc.maxVotes, tc.maxBytes)
+			require.Len(t, batches, tc.wantBatches)
+			assert.Empty(t, oversized)
+
+			total := []*oracleproto.Vote{}
+			for seq, batch := range batches {
+				assert.LessOrEqual(t, len(batch), tc.maxVotes)
+
+				signed := &oracleproto.GossipedVotes{
+					PubKey:          pubKey,
+					SignedTimestamp: 1700000000,
+					Votes:           batch,
+					Signature:       make([]byte, maxSignatureSize),
+					BatchSeq:        uint32(seq),
+				}
+				assert.LessOrEqual(t, signed.Size(), tc.maxBytes)
+				total = append(total, batch...)
+			}
+			// votes are kept in order across batches
+			assert.Equal(t, votes, append([]*oracleproto.Vote{}, total...))
+		})
+	}
+}
+
+func TestSplitOracleVotesOversizedVote(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey().Bytes()
+	votes := makeVotes(3)
+	votes[1].Data = string(make([]byte, 1000))
+
+	// the oversized vote is left out rather than sent in a batch of its own
+	batches, oversized := SplitOracleVotes(pubKey, votes, 10, 500)
+	require.Len(t, batches, 1)
+	assert.Equal(t, []*oracleproto.Vote{votes[0], votes[2]}, batches[0])
+	assert.Equal(t, []*oracleproto.Vote{votes[1]}, oversized)
+}
+
+func TestHashLargeVoteData(t *testing.T) {
+	store := types.NewVoteDataStore(0, nil)
+	small := &oracleproto.Vote{OracleId: "small", Data: "1"}
+	large := &oracleproto.Vote{OracleId: "large", Data: strings.Repeat("x", 100)}
+	tooLarge := &oracleproto.Vote{OracleId: "too-large", Data: strings.Repeat("x", 1001)}
+
+	hashed, oversized := HashLargeVoteData([]*oracleproto.Vote{small, large, tooLarge}, 10, 1000, store)
+	require.Len(t, hashed, 2)
+	assert.Equal(t, []*oracleproto.Vote{tooLarge}, oversized)
+	assert.Equal(t, small, hashed[0])
+	assert.Equal(t, "", hashed[1].Data)
+	assert.Equal(t, cmttypes.OracleVoteDataHash(large.Data), hashed[1].DataHash)
+	assert.Equal(t, strings.Repeat("x", 100), large.Data)
+
+	data, ok := store.Get(hashed[1].DataHash)
+	assert.True(t, ok)
+	assert.Equal(t, large.Data, data)
+}
+
+func TestProcessSignVoteQueue(t *testing.T) {
+	cfg := config.TestOracleConfig()
+	cfg.MaxVotesPerBatch = 2
+	pv := runnertest.NewPrivValidator("validator")
+	oracleInfo := runnertest.NewOracleInfo(cfg, pv, runnertest.NewApp())
+	cs := runnertest.NewConsensusState(time.Now())
+
+	// nothing to sign
+	ProcessSignVoteQueue(oracleInfo, cs)
+	assert.Empty(t, oracleInfo.State.Batches())
+
+	for _, vote := range makeVotes(5) {
+		oracleInfo.SignVotesChan <- vote
+	}
+	ProcessSignVoteQueue(oracleInfo, cs)
+	batches := requireSignedBatches(t, oracleInfo)
+	assert.Len(t, batches, 3)
+
+	// new votes are signed along with the previous votes, replacing all of
+	// the previous batches
+	oracleInfo.SignVotesChan <- &oracleproto.Vote{Validator: "validator", OracleId: "oracle-5", Timestamp: 1700000000}
+	ProcessSignVoteQueue(oracleInfo, cs)
+	batches = requireSignedBatches(t, oracleInfo)
+	assert.Len(t, batches, 3)
+
+	votes := []*oracleproto.Vote{}
+	for _, batch := range batches {
+		votes = append(votes, batch.Votes...)
+	}
+	assert.Len(t, votes, 6)
+	assert.Len(t, oracleInfo.State.UnsignedVotes(), 6)
+}
+
+func TestProcessSignVoteQueueOversizedVote(t *testing.T) {
+	pv := runnertest.NewPrivValidator("validator")
+	oracleInfo := runnertest.NewOracleInfo(config.TestOracleConfig(), pv, runnertest.NewApp())
+	cs := runnertest.NewConsensusState(time.Now())
+
+	votes := makeVotes(3)
+	votes[1].Data = strings.Repeat("x", 2000)
+	for _, vote := range votes {
+		oracleInfo.SignVotesChan <- vote
+	}
+	ProcessSignVoteQueue(oracleInfo, cs)
+
+	// the vote too large to be gossiped is dropped rather than signed
+	batches := requireSignedBatches(t, oracleInfo)
+	require.Len(t, batches, 1)
+	assert.Equal(t, []*oracleproto.Vote{votes[0], votes[2]}, batches[0].Votes)
+	assert.Equal(t, []*oracleproto.Vote{votes[0], votes[2]}, oracleInfo.State.UnsignedVotes())
+}
+
+func TestProcessSignVoteQueueTxFallbackDataByHash(t *testing.T) {
+	cfg := config.TestOracleConfig()
+	cfg.MaxInlineDataSize = 10
+	cfg.TxFallbackRoute = "oracle"
+	pv := runnertest.NewPrivValidator("validator")
+	oracleInfo := runnertest.NewOracleInfo(cfg, pv, runnertest.NewApp())
+	mp := &txMempool{}
+	oracleInfo.Mempool = mp
+	cs := runnertest.NewConsensusState(time.Now())
+
+	large := strings.Repeat("x", 100)
+	oracleInfo.SignVotesChan <- &oracleproto.Vote{Validator: "validator", OracleId: "oracle", Timestamp: 1700000000, Data: large}
+	ProcessSignVoteQueue(oracleInfo, cs)
+
+	// the batch is gossiped by data hash, but the tx carries the data
+	batches := requireSignedBatches(t, oracleInfo)
+	require.Len(t, batches, 1)
+	assert.Empty(t, batches[0].Votes[0].Data)
+	require.Len(t, mp.txs, 1)
+	batch, err := utils.ParseGossipedVotesTx(cfg.TxFallbackRoute, mp.txs[0])
+	require.NoError(t, err)
+	require.Len(t, batch.Votes, 1)
+	assert.Equal(t, large, batch.Votes[0].Data)
+	assert.Equal(t, batches[0].Votes[0].DataHash, batch.Votes[0].DataHash)
+}
+
+// txMempool records the txs checked by it.
+type txMempool struct {
+	mempool.NopMempool
+	txs []cmttypes.Tx
+}
+
+func (mp *txMempool) CheckTx(tx cmttypes.Tx, _ func(*abcitypes.ResponseCheckTx), _ mempool.TxInfo) error {
+	mp.txs = append(mp.txs, tx)
+	return nil
+}
+
+func TestProcessSignVoteQueueDeterministic(t *testing.T) {
+	started := time.Unix(1700000000, 0)
+	signed := time.Unix(1700000001, 0)
+	sign := func() []byte {
+		oracleInfo := runnertest.NewOracleInfo(config.TestOracleConfig(), runnertest.NewPrivValidator("validator"), runnertest.NewApp())
+		now := started
+		oracleInfo.Clock = func() time.Time { return now }
+		cs := runnertest.NewConsensusState(time.Now())
+
+		// the warm-up period is measured by the clock, so the same votes are
+		// dropped on every run
+		oracleInfo.WarmUp(time.Second)
+		oracleInfo.SignVotesChan <- &oracleproto.Vote{Validator: "validator", OracleId: "warm-up", Timestamp: 1700000000}
+		ProcessSignVoteQueue(oracleInfo, cs)
+		assert.Empty(t, oracleInfo.State.Batches())
+
+		now = signed
+		for _, vote := range makeVotes(3) {
+			oracleInfo.SignVotesChan <- vote
+		}
+		ProcessSignVoteQueue(oracleInfo, cs)
+
+		batches := requireSignedBatches(t, oracleInfo)
+		require.Len(t, batches, 1)
+		assert.Equal(t, signed.Unix(), batches[0].SignedTimestamp)
+		bz, err := batches[0].Marshal()
+		require.NoError(t, err)
+		return bz
+	}
+
+	// the same votes signed at the same time give byte-identical batches
+	assert.Equal(t, sign(), sign())
+}
+
+func TestProcessSignVoteQueueSignerMetadata(t *testing.T) {
+	cfg := config.TestOracleConfig()
+	cfg.SignerMoniker = strings.Repeat("m", 128)
+	cfg.SignerOperator = strings.Repeat("o", 128)
+	oracleInfo := runnertest.NewOracleInfo(cfg, runnertest.NewPrivValidator("validator"), runnertest.NewApp())
+	cs := runnertest.NewConsensusState(time.Now())
+
+	for _, vote := range makeVotes(50) {
+		oracleInfo.SignVotesChan <- vote
+	}
+	ProcessSignVoteQueue(oracleInfo, cs)
+
+	// the metadata is not signed, and is accounted for when splitting the
+	// votes into batches
+	batches := requireSignedBatches(t, oracleInfo)
+	require.Greater(t, len(batches), 1)
+	for _, batch := range batches {
+		assert.Equal(t, cfg.SignerMoniker, batch.SignerMetadata.Moniker)
+		assert.Equal(t, cfg.SignerOperator, batch.SignerMetadata.Operator)
+		assert.LessOrEqual(t, batch.Size(), cfg.MaxGossipMsgSize)
+	}
+}
+
+// requireSignedBatches returns the batches signed by the validator, ordered
+// by sequence number, checking their signatures.
+func requireSignedBatches(t *testing.T, oracleInfo *types.OracleInfo) []*oracleproto.GossipedVotes {
+	t.Helper()
+
+	addr := types.ValAddressFromPubKey(oracleInfo.PubKey)
+	all := oracleInfo.State.Batches()
+	batches := make([]*oracleproto.GossipedVotes, 0, len(all))
+	for seq := uint32(0); ; seq++ {
+		batch, ok := all[types.GossipVoteKey{Address: addr, BatchSeq: seq}]
+		if !ok {
+			break
+		}
+		signBytes := cmttypes.OracleVoteSignBytes(runnertest.ChainID, batch)
+		require.True(t, oracleInfo.PubKey.VerifySignature(signBytes, batch.Signature[2:]), "batch %d", seq)
+		batches = append(batches, batch)
+	}
+	require.Len(t, batches, len(all))
+	return batches
+}
+
+func TestPruneVoteBuffers(t *testing.T) {
+	now := time.Now()
+	cfg := config.TestOracleConfig()
+	cfg.PruneInterval = 10 * time.Millisecond
+	cfg.MaxOracleGossipAge = 60
+	cfg.MaxOracleGossipBlocksDelayed = 2
+	app := runnertest.NewApp()
+	oracleInfo := runnertest.NewOracleInfo(cfg, runnertest.NewPrivValidator("validator"), app)
+	cs := runnertest.NewConsensusState(now.Add(-20 * time.Second))
+
+	expired := &oracleproto.Vote{OracleId: "expired", Timestamp: now.Add(-2 * time.Minute).Unix()}
+	committed := &oracleproto.Vote{OracleId: "committed", Timestamp: now.Unix()}
+	delayed := &oracleproto.Vote{OracleId: "delayed", Timestamp: now.Add(-30 * time.Second).Unix()}
+	fresh := &oracleproto.Vote{OracleId: "fresh", Timestamp: now.Unix()}
+	app.CommitResult(committed)
+	oracleInfo.State.AddUnsigned(expired, committed, delayed, fresh, fresh)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	PruneVoteBuffers(ctx, oracleInfo, cs)
+
+	// votes older than the max gossip age, committed and duplicate votes are
+	// pruned, votes within the max gossip age are kept until enough blocks
+	// have been committed
+	assert.Eventually(t, func() bool {
+		return len(oracleInfo.State.UnsignedVotes()) == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []*oracleproto.Vote{delayed, fresh}, oracleInfo.State.UnsignedVotes())
+
+	// votes older than the earliest of the last blocks are pruned
+	cs.Commit(2, now.Add(-10*time.Second))
+	assert.Eventually(t, func() bool {
+		return len(oracleInfo.State.UnsignedVotes()) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []*oracleproto.Vote{fresh}, oracleInfo.State.UnsignedVotes())
+}
+
+func TestRunStopsFetchingOnCancel(t *testing.T) {
+	oracleInfo := runnertest.NewOracleInfo(config.TestOracleConfig(), runnertest.NewPrivValidator("validator"), runnertest.NewApp())
+	oracleInfo.VoteSource = blockingVoteSource{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		Run(ctx, oracleInfo, runnertest.NewConsensusState(time.Now()))
+		close(done)
+	}()
+
+	// the fetch waiting on the vote source is canceled
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("runner did not stop")
+	}
+}
+
+func TestFetchOracleVoteTimeout(t *testing.T) {
+	cfg := config.TestOracleConfig()
+	cfg.FetchTimeout = 10 * time.Millisecond
+	oracleInfo := runnertest.NewOracleInfo(cfg, runnertest.NewPrivValidator("validator"), runnertest.NewApp())
+	oracleInfo.VoteSource = blockingVoteSource{}
+
+	_, err := fetchOracleVote(context.Background(), oracleInfo)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWaitForSignQueue(t *testing.T) {
+	cfg := config.TestOracleConfig()
+	cfg.SignQueueHighWatermark = 4
+	cfg.SignInterval = time.Millisecond
+	oracleInfo := runnertest.NewOracleInfo(cfg, runnertest.NewPrivValidator("validator"), runnertest.NewApp())
+
+	for _, vote := range makeVotes(3) {
+		oracleInfo.SignVotesChan <- vote
+	}
+	assert.True(t, waitForSignQueue(context.Background(), oracleInfo))
+
+	// fetching resumes once half of the votes are signed
+	oracleInfo.SignVotesChan <- &oracleproto.Vote{OracleId: "oracle-3"}
+	done := make(chan bool)
+	go func() { done <- waitForSignQueue(context.Background(), oracleInfo) }()
+	for _, waiting := range []int{4, 3} {
+		select {
+		case <-done:
+			t.Fatalf("expected fetching to wait with %d votes waiting to be signed", waiting)
+		case <-time.After(20 * time.Millisecond):
+		}
+		<-oracleInfo.SignVotesChan
+	}
+	select {
+	case resumed := <-done:
+		assert.True(t, resumed)
+	case <-time.After(time.Second):
+		t.Fatal("expected fetching to resume with 2 votes waiting to be signed")
+	}
+
+	// the wait is canceled along with the runner
+	oracleInfo.SignVotesChan <- &oracleproto.Vote{OracleId: "oracle-4"}
+	oracleInfo.SignVotesChan <- &oracleproto.Vote{OracleId: "oracle-5"}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.False(t, waitForSignQueue(ctx, oracleInfo))
+}
+
+// blockingVoteSource holds every fetch until it is canceled.
+type blockingVoteSource struct{}
+
+func (blockingVoteSource) FetchOracleVotes(ctx context.Context, _ *abcitypes.RequestFetchOracleVotes) (*abcitypes.ResponseFetchOracleVotes, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func TestProcessSignVoteQueuePaused(t *testing.T) {
+	pv := runnertest.NewPrivValidator("validator")
+	oracleInfo := runnertest.NewOracleInfo(config.TestOracleConfig(), pv, runnertest.NewApp())
+	cs := runnertest.NewConsensusState(time.Now())
+
+	oracleInfo.PauseSigning()
+	for _, vote := range makeVotes(3) {
+		oracleInfo.SignVotesChan <- vote
+	}
+	ProcessSignVoteQueue(oracleInfo, cs)
+	assert.Empty(t, oracleInfo.State.Batches())
+	assert.Len(t, oracleInfo.State.UnsignedVotes(), 3)
+
+	// votes fetched while paused are signed once resumed
+	oracleInfo.ResumeSigning()
+	oracleInfo.SignVotesChan <- &oracleproto.Vote{Validator: "validator", OracleId: "oracle-3", Timestamp: 1700000000}
+	ProcessSignVoteQueue(oracleInfo, cs)
+	batches := requireSignedBatches(t, oracleInfo)
+	require.Len(t, batches, 1)
+	assert.Len(t, batches[0].Votes, 4)
+}
+
+func TestProcessSignVoteQueueWarmingUp(t *testing.T) {
+	pv := runnertest.NewPrivValidator("validator")
+	oracleInfo := runnertest.NewOracleInfo(config.TestOracleConfig(), pv, runnertest.NewApp())
+	cs := runnertest.NewConsensusState(time.Now())
+
+	oracleInfo.WarmUp(50 * time.Millisecond)
+	for _, vote := range makeVotes(3) {
+		oracleInfo.SignVotesChan <- vote
+	}
+	ProcessSignVoteQueue(oracleInfo, cs)
+	assert.Empty(t, oracleInfo.State.Batches())
+	assert.Empty(t, oracleInfo.State.UnsignedVotes())
+
+	// votes fetched once warmed up are signed, without the dropped votes
+	time.Sleep(50 * time.Millisecond)
+	oracleInfo.SignVotesChan <- &oracleproto.Vote{Validator: "validator", OracleId: "oracle-3", Timestamp: 1700000000}
+	ProcessSignVoteQueue(oracleInfo, cs)
+	batches := requireSignedBatches(t, oracleInfo)
+	require.Len(t, batches, 1)
+	assert.Len(t, batches[0].Votes, 1)
+}
+
+func TestProcessSignVoteQueueCatchingUp(t *testing.T) {
+	pv := runnertest.NewPrivValidator("validator")
+	oracleInfo := runnertest.NewOracleInfo(config.TestOracleConfig(), pv, runnertest.NewApp())
+	syncStatus := &runnertest.SyncStatus{}
+	oracleInfo.SyncStatus = syncStatus
+
+	// no consensus state yet
+	oracleInfo.SignVotesChan <- makeVotes(1)[0]
+	ProcessSignVoteQueue(oracleInfo, nil)
+	assert.Empty(t, oracleInfo.State.Batches())
+
+	// block syncing or replaying the WAL
+	syncStatus.SetSyncing(true)
+	cs := runnertest.NewConsensusState(time.Now())
+	oracleInfo.SignVotesChan <- makeVotes(2)[1]
+	ProcessSignVoteQueue(oracleInfo, cs)
+	assert.Empty(t, oracleInfo.State.Batches())
+	assert.Len(t, oracleInfo.State.UnsignedVotes(), 2)
+
+	// votes queued while catching up are signed once caught up, without
+	// waiting for new votes
+	syncStatus.SetSyncing(false)
+	ProcessSignVoteQueue(oracleInfo, cs)
+	batches := requireSignedBatches(t, oracleInfo)
+	require.Len(t, batches, 1)
+	assert.Len(t, batches[0].Votes, 2)
+
+	// nothing new to sign
+	ProcessSignVoteQueue(oracleInfo, cs)
+	assert.Same(t, batches[0], requireSignedBatches(t, oracleInfo)[0])
+}
+
+func TestPublishHeartbeat(t *testing.T) {
+	pv := runnertest.NewPrivValidator("validator")
+	oracleInfo := runnertest.NewOracleInfo(config.TestOracleConfig(), pv, runnertest.NewApp())
+	cs := runnertest.NewConsensusState(time.Now())
+
+	PublishHeartbeat(oracleInfo, cs)
+	heartbeats := oracleInfo.State.Heartbeats()
+	require.Len(t, heartbeats, 1)
+	assert.Empty(t, heartbeats[0].Votes)
+	assert.Empty(t, oracleInfo.State.Batches())
+	signBytes := cmttypes.OracleVoteSignBytes(runnertest.ChainID, heartbeats[0])
+	assert.True(t, oracleInfo.PubKey.VerifySignature(signBytes, heartbeats[0].Signature[2:]))
+
+	// no heartbeat while there are votes to sign
+	oracleInfo = runnertest.NewOracleInfo(config.TestOracleConfig(), pv, runnertest.NewApp())
+	oracleInfo.State.AddUnsigned(makeVotes(1)...)
+	PublishHeartbeat(oracleInfo, cs)
+	assert.Empty(t, oracleInfo.State.Heartbeats())
+}
@@ -0,0 +1,232 @@
+// Package runnertest provides test doubles for testing the oracle runner
+// and reactor: deterministic signers, a scripted consensus state and a
+// scripted app.
+package runnertest
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/crypto/sr25519"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	oracletypes "github.com/cometbft/cometbft/oracle/service/types"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// ChainID is the chain ID of the scripted consensus state.
+const ChainID = "runnertest-chain"
+
+// NewPrivValidator returns a mock signer whose key is derived from seed, so
+// the same seed always gives the same key.
+func NewPrivValidator(seed string) types.MockPV {
+	return NewPrivValidatorWithKeyType(ed25519.KeyType, seed)
+}
+
+// NewPrivValidatorWithKeyType returns a mock signer whose key of the given
+// type is derived from seed, e.g. to test the votes signed by validators using
+// each of the supported key types.
+func NewPrivValidatorWithKeyType(keyType, seed string) types.MockPV {
+	var privKey crypto.PrivKey
+	switch keyType {
+	case ed25519.KeyType:
+		privKey = ed25519.GenPrivKeyFromSecret([]byte(seed))
+	case sr25519.KeyType:
+		privKey = sr25519.GenPrivKeyFromSecret([]byte(seed))
+	case secp256k1.KeyType:
+		privKey = secp256k1.GenPrivKeySecp256k1([]byte(seed))
+	default:
+		panic(fmt.Sprintf("unsupported key type %q", keyType))
+	}
+	return types.NewMockPVWithParams(privKey, false, false)
+}
+
+// NewOracleInfo returns an OracleInfo signing with pv and fetching votes
+// from app, as set up by the oracle reactor.
+func NewOracleInfo(cfg *config.OracleConfig, pv types.PrivValidator, app proxy.AppConnConsensus) *oracletypes.OracleInfo {
+	pubKey, err := pv.GetPubKey()
+	if err != nil {
+		panic(err)
+	}
+	oracleInfo := &oracletypes.OracleInfo{
+		Config:        cfg,
+		State:         oracletypes.NewOracleState(cfg.GossipBufferBytes(), cfg.MaxUnsignedVotesPerOracle),
+		SignVotesChan: make(chan *oracleproto.Vote, cfg.SignQueueCapacity),
+		PubKey:        pubKey,
+		PrivValidator: pv,
+		ProxyApp:      app,
+		VoteSource:    app,
+		Metrics:       oracletypes.NopMetrics(),
+		BatchLatency:  &oracletypes.BatchLatency{},
+	}
+	// the clock is read through oracleInfo, as tests may set it
+	oracleInfo.VoteDataStore = oracletypes.NewVoteDataStore(cfg.VoteDataBytes(), oracleInfo.Now)
+	return oracleInfo
+}
+
+//-----------------------------------------------------------------------------
+
+// ConsensusState is a consensus state whose height, block time and
+// validators are set by the test. It is safe for concurrent use.
+type ConsensusState struct {
+	mtx        cmtsync.RWMutex
+	height     int64
+	blockTime  time.Time
+	validators *types.ValidatorSet
+}
+
+// NewConsensusState returns a consensus state at height 1 with the given
+// validators.
+func NewConsensusState(blockTime time.Time, validators ...*types.Validator) *ConsensusState {
+	return &ConsensusState{
+		height:     1,
+		blockTime:  blockTime,
+		validators: types.NewValidatorSet(validators),
+	}
+}
+
+// Commit moves the consensus state to a new height, committed at blockTime.
+func (cs *ConsensusState) Commit(height int64, blockTime time.Time) {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+
+	cs.height = height
+	cs.blockTime = blockTime
+}
+
+// SetValidators replaces the validator set.
+func (cs *ConsensusState) SetValidators(validators ...*types.Validator) {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+
+	cs.validators = types.NewValidatorSet(validators)
+}
+
+// GetState implements runner.ConsensusState.
+func (cs *ConsensusState) GetState() sm.State {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+
+	return sm.State{
+		ChainID:         ChainID,
+		LastBlockHeight: cs.height,
+		LastBlockTime:   cs.blockTime,
+		Validators:      cs.validators,
+		NextValidators:  cs.validators,
+		LastValidators:  cs.validators,
+	}
+}
+
+// GetLastHeight implements runner.ConsensusState.
+func (cs *ConsensusState) GetLastHeight() int64 {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+
+	return cs.height
+}
+
+// GetValidators implements runner.ConsensusState.
+func (cs *ConsensusState) GetValidators() (int64, []*types.Validator) {
+	cs.mtx.RLock()
+	defer cs.mtx.RUnlock()
+
+	return cs.height, cs.validators.Validators
+}
+
+// SyncStatus is a sync status set by the test. It is safe for concurrent use.
+type SyncStatus struct {
+	syncing atomic.Bool
+}
+
+// SetSyncing sets whether the node is catching up.
+func (s *SyncStatus) SetSyncing(syncing bool) {
+	s.syncing.Store(syncing)
+}
+
+// CatchingUp implements types.SyncStatus.
+func (s *SyncStatus) CatchingUp() bool {
+	return s.syncing.Load()
+}
+
+//-----------------------------------------------------------------------------
+
+// App is a proxy app serving the votes queued by the test and reporting the
+// results committed by the test as existing. Only the methods used by the
+// oracle are implemented.
+type App struct {
+	proxy.AppConnConsensus
+
+	votes chan *oracleproto.Vote
+
+	mtx     cmtsync.RWMutex
+	results map[string]struct{}
+	subAccs map[string]struct{}
+}
+
+// NewApp returns an app with no votes queued and no results committed.
+func NewApp() *App {
+	return &App{
+		votes:   make(chan *oracleproto.Vote, 1024),
+		results: make(map[string]struct{}),
+		subAccs: make(map[string]struct{}),
+	}
+}
+
+// QueueVotes queues votes to be returned by FetchOracleVotes, in order.
+func (app *App) QueueVotes(votes ...*oracleproto.Vote) {
+	for _, vote := range votes {
+		app.votes <- vote
+	}
+}
+
+// CommitResult marks the result for the vote as committed on chain.
+func (app *App) CommitResult(vote *oracleproto.Vote) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	app.results[oracletypes.UnsignedVoteKey(vote)] = struct{}{}
+}
+
+// AddSubAccount marks the address as a sub account of a validator.
+func (app *App) AddSubAccount(address []byte) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+
+	app.subAccs[string(address)] = struct{}{}
+}
+
+// FetchOracleVotes returns the next queued vote, or no vote if none is
+// queued within a short time.
+func (app *App) FetchOracleVotes(context.Context, *abcitypes.RequestFetchOracleVotes) (*abcitypes.ResponseFetchOracleVotes, error) {
+	select {
+	case vote := <-app.votes:
+		return &abcitypes.ResponseFetchOracleVotes{Vote: vote}, nil
+	case <-time.After(10 * time.Millisecond):
+		return &abcitypes.ResponseFetchOracleVotes{}, nil
+	}
+}
+
+func (app *App) DoesOracleResultExist(_ context.Context, req *abcitypes.RequestDoesOracleResultExist) (*abcitypes.ResponseDoesOracleResultExist, error) {
+	app.mtx.RLock()
+	defer app.mtx.RUnlock()
+
+	_, ok := app.results[req.Key]
+	return &abcitypes.ResponseDoesOracleResultExist{DoesExist: ok}, nil
+}
+
+func (app *App) DoesSubAccountBelongToVal(_ context.Context, req *abcitypes.RequestDoesSubAccountBelongToVal) (*abcitypes.ResponseDoesSubAccountBelongToVal, error) {
+	app.mtx.RLock()
+	defer app.mtx.RUnlock()
+
+	_, ok := app.subAccs[string(req.Address)]
+	return &abcitypes.ResponseDoesSubAccountBelongToVal{BelongsToVal: ok}, nil
+}
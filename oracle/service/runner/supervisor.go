@@ -0,0 +1,69 @@
+package runner
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/cometbft/cometbft/oracle/service/types"
+)
+
+const (
+	// minRestartBackoff is the wait before restarting a routine after its
+	// first crash, doubling on every consecutive crash up to maxRestartBackoff
+	minRestartBackoff = 1 * time.Second
+	maxRestartBackoff = 1 * time.Minute
+)
+
+// supervise runs routine until it returns, restarting it whenever it panics,
+// so that a crash does not silently stop the oracle while the node keeps
+// running. Restarts are delayed by an exponential backoff, which is reset
+// once the routine has run for longer than maxRestartBackoff. It is not
+// restarted once ctx is canceled.
+func supervise(ctx context.Context, oracleInfo *types.OracleInfo, name string, routine func()) {
+	crashes := 0
+	for {
+		start := time.Now()
+		if !runRecovered(name, routine) {
+			return
+		}
+
+		if time.Since(start) > maxRestartBackoff {
+			crashes = 0
+		}
+		crashes++
+		oracleInfo.Metrics.RoutineRestarts.With("routine", name).Add(1)
+
+		backoff := restartBackoff(crashes)
+		log.Errorf("supervise: oracle %v routine crashed %v times in a row, restarting in %v", name, crashes, backoff)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runRecovered runs routine, returning true if it panicked.
+func runRecovered(name string, routine func()) (crashed bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("supervise: oracle %v routine panicked: %v\n%s", name, r, debug.Stack())
+			crashed = true
+		}
+	}()
+
+	routine()
+	return false
+}
+
+// restartBackoff returns the wait before restarting a routine after the given
+// number of consecutive crashes.
+func restartBackoff(crashes int) time.Duration {
+	if shift := crashes - 1; shift < 16 && minRestartBackoff<<shift < maxRestartBackoff {
+		return minRestartBackoff << shift
+	}
+	return maxRestartBackoff
+}
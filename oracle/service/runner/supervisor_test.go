@@ -0,0 +1,21 @@
+package runner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunRecovered(t *testing.T) {
+	assert.False(t, runRecovered("test", func() {}))
+	assert.True(t, runRecovered("test", func() { panic("boom") }))
+}
+
+func TestRestartBackoff(t *testing.T) {
+	assert.Equal(t, minRestartBackoff, restartBackoff(1))
+	assert.Equal(t, 2*minRestartBackoff, restartBackoff(2))
+	assert.Equal(t, 32*time.Second, restartBackoff(6))
+	assert.Equal(t, maxRestartBackoff, restartBackoff(7))
+	assert.Equal(t, maxRestartBackoff, restartBackoff(100))
+}
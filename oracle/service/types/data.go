@@ -0,0 +1,228 @@
+package types
+
+import (
+	"bytes"
+	"container/list"
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// VoteDataStore holds the data of votes gossiped by data hash, keyed by the
+// hash, so that it can be served to peers and filled back into the votes.
+type VoteDataStore struct {
+	mtx      cmtsync.RWMutex
+	maxBytes int
+	bytes    int
+	data     map[string]*list.Element
+	// data in the order it was added, the oldest at the front
+	list *list.List
+	// clock the data is added at
+	now func() time.Time
+}
+
+type voteData struct {
+	hash    string
+	data    string
+	addedAt time.Time
+	// data of our own votes, which is never evicted, only pruned
+	own bool
+}
+
+// NewVoteDataStore returns a store holding at most maxBytes of vote data,
+// beyond which the oldest data received from peers is evicted. The data of
+// our own votes is kept until it is pruned, as our batches refer to it. Zero
+// does not bound the data. The data is added at the time returned by now, nil
+// for the system clock.
+func NewVoteDataStore(maxBytes int, now func() time.Time) *VoteDataStore {
+	if now == nil {
+		now = time.Now
+	}
+	return &VoteDataStore{
+		maxBytes: maxBytes,
+		data:     make(map[string]*list.Element),
+		list:     list.New(),
+		now:      now,
+	}
+}
+
+// Add stores the data of one of our own votes and returns its hash.
+func (s *VoteDataStore) Add(data string) []byte {
+	hash := cmttypes.OracleVoteDataHash(data)
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.addLocked(string(hash), data, true)
+	return hash
+}
+
+// AddWithHash stores the data received from a peer if it matches the given
+// hash, returning false otherwise. Data larger than the max size of the store
+// is not stored.
+func (s *VoteDataStore) AddWithHash(hash []byte, data string) bool {
+	if !bytes.Equal(cmttypes.OracleVoteDataHash(data), hash) {
+		return false
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.maxBytes > 0 && len(data) > s.maxBytes {
+		return true
+	}
+	s.addLocked(string(hash), data, false)
+	return true
+}
+
+// addLocked stores the data as the most recently added, evicting the oldest
+// data received from peers if the store exceeds its max size.
+func (s *VoteDataStore) addLocked(hash, data string, own bool) {
+	if e, ok := s.data[hash]; ok {
+		own = own || e.Value.(*voteData).own
+		s.removeLocked(e)
+	}
+	s.data[hash] = s.list.PushBack(&voteData{hash: hash, data: data, addedAt: s.now(), own: own})
+	s.bytes += len(data)
+
+	for e := s.list.Front(); e != nil && s.maxBytes > 0 && s.bytes > s.maxBytes; {
+		next := e.Next()
+		if !e.Value.(*voteData).own {
+			s.removeLocked(e)
+		}
+		e = next
+	}
+}
+
+func (s *VoteDataStore) removeLocked(e *list.Element) {
+	d := s.list.Remove(e).(*voteData)
+	delete(s.data, d.hash)
+	s.bytes -= len(d.data)
+}
+
+// Get returns the data with the given hash, if present.
+func (s *VoteDataStore) Get(hash []byte) (string, bool) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	e, ok := s.data[string(hash)]
+	if !ok {
+		return "", false
+	}
+	return e.Value.(*voteData).data, true
+}
+
+// Has returns true if the data with the given hash is present.
+func (s *VoteDataStore) Has(hash []byte) bool {
+	_, ok := s.Get(hash)
+	return ok
+}
+
+// Size returns the number of payloads in the store.
+func (s *VoteDataStore) Size() int {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return len(s.data)
+}
+
+// Bytes returns the total size of the payloads in the store.
+func (s *VoteDataStore) Bytes() int {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	return s.bytes
+}
+
+// Prune removes the data added before the given time.
+func (s *VoteDataStore) Prune(before time.Time) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for e := s.list.Front(); e != nil && e.Value.(*voteData).addedAt.Before(before); e = s.list.Front() {
+		s.removeLocked(e)
+	}
+}
+
+// MissingData returns the data hashes of the votes whose data is not in the
+// store.
+func (s *VoteDataStore) MissingData(votes []*oracleproto.Vote) [][]byte {
+	missing := [][]byte{}
+	for _, vote := range votes {
+		if len(vote.DataHash) > 0 && vote.Data == "" && !s.Has(vote.DataHash) {
+			missing = append(missing, vote.DataHash)
+		}
+	}
+	return missing
+}
+
+// FillData returns the batches with the data of votes gossiped by data hash
+// filled in from the store. Batches are copied rather than modified, and
+// votes whose data is not in the store are left as they are.
+func (s *VoteDataStore) FillData(batches []*oracleproto.GossipedVotes) []*oracleproto.GossipedVotes {
+	filled := make([]*oracleproto.GossipedVotes, 0, len(batches))
+	for _, batch := range batches {
+		var votes []*oracleproto.Vote
+		for i, vote := range batch.Votes {
+			if len(vote.DataHash) == 0 || vote.Data != "" {
+				continue
+			}
+			data, ok := s.Get(vote.DataHash)
+			if !ok {
+				continue
+			}
+			if votes == nil {
+				votes = append([]*oracleproto.Vote{}, batch.Votes...)
+			}
+			v := *vote
+			v.Data = data
+			votes[i] = &v
+		}
+
+		if votes == nil {
+			filled = append(filled, batch)
+			continue
+		}
+		b := *batch
+		b.Votes = votes
+		filled = append(filled, &b)
+	}
+	return filled
+}
+
+// FillCompleteData returns the batches with the data of votes gossiped by
+// data hash filled in from the store, like FillData, but leaves out the
+// batches with votes whose data is not in the store, returning the number of
+// batches left out. Such votes can't be left out of their batch instead, as
+// the batch signature commits to all of its votes.
+func (s *VoteDataStore) FillCompleteData(batches []*oracleproto.GossipedVotes) ([]*oracleproto.GossipedVotes, int) {
+	filled := s.FillData(batches)
+	complete := make([]*oracleproto.GossipedVotes, 0, len(filled))
+	for _, batch := range filled {
+		if len(s.MissingData(batch.Votes)) > 0 {
+			continue
+		}
+		complete = append(complete, batch)
+	}
+	return complete, len(filled) - len(complete)
+}
+
+// WithoutUnsignedMetadata returns the batches without their relay hops and
+// signer metadata, which are only informational and are not signed. Batches
+// with such metadata are copied rather than modified.
+func WithoutUnsignedMetadata(batches []*oracleproto.GossipedVotes) []*oracleproto.GossipedVotes {
+	stripped := make([]*oracleproto.GossipedVotes, 0, len(batches))
+	for _, batch := range batches {
+		if len(batch.RelayHops) == 0 && batch.SignerMetadata == nil {
+			stripped = append(stripped, batch)
+			continue
+		}
+		b := *batch
+		b.RelayHops = nil
+		b.SignerMetadata = nil
+		stripped = append(stripped, &b)
+	}
+	return stripped
+}
@@ -0,0 +1,157 @@
+package types
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+func TestVoteDataStore(t *testing.T) {
+	store := NewVoteDataStore(0, nil)
+
+	data := strings.Repeat("x", 1024)
+	hash := store.Add(data)
+	got, ok := store.Get(hash)
+	assert.True(t, ok)
+	assert.Equal(t, data, got)
+
+	assert.False(t, store.AddWithHash(hash, "tampered"))
+	assert.True(t, store.AddWithHash(cmttypes.OracleVoteDataHash("other"), "other"))
+	assert.Equal(t, 2, store.Size())
+
+	store.Prune(time.Now().Add(time.Second))
+	assert.Equal(t, 0, store.Size())
+}
+
+func TestVoteDataStoreMaxBytes(t *testing.T) {
+	store := NewVoteDataStore(2048, nil)
+	add := func(data string) []byte {
+		hash := cmttypes.OracleVoteDataHash(data)
+		assert.True(t, store.AddWithHash(hash, data))
+		return hash
+	}
+
+	oldest := add(strings.Repeat("a", 1024))
+	older := add(strings.Repeat("b", 1024))
+	assert.Equal(t, 2048, store.Bytes())
+
+	// re-adding data makes it the most recently added
+	add(strings.Repeat("a", 1024))
+	add(strings.Repeat("c", 512))
+	assert.Equal(t, 1536, store.Bytes())
+	assert.True(t, store.Has(oldest))
+	assert.False(t, store.Has(older))
+
+	// data larger than the store is not stored
+	assert.False(t, store.Has(add(strings.Repeat("d", 2049))))
+	assert.Equal(t, 1536, store.Bytes())
+
+	store.Prune(time.Now().Add(time.Second))
+	assert.Zero(t, store.Bytes())
+}
+
+func TestVoteDataStoreClock(t *testing.T) {
+	now := time.Unix(1000, 0)
+	store := NewVoteDataStore(0, func() time.Time { return now })
+
+	old := store.Add("old")
+	now = now.Add(time.Minute)
+	fresh := store.Add("fresh")
+
+	// the data is pruned against the time it was added at by the clock
+	store.Prune(now)
+	assert.False(t, store.Has(old))
+	assert.True(t, store.Has(fresh))
+}
+
+func TestVoteDataStoreKeepsOwnData(t *testing.T) {
+	store := NewVoteDataStore(2048, nil)
+
+	own := store.Add(strings.Repeat("a", 1024))
+	// data received from peers under memory pressure only evicts data received from peers
+	for _, data := range []string{strings.Repeat("b", 1024), strings.Repeat("c", 1024), strings.Repeat("d", 1024)} {
+		assert.True(t, store.AddWithHash(cmttypes.OracleVoteDataHash(data), data))
+		assert.True(t, store.Has(own))
+	}
+	assert.Equal(t, 2048, store.Bytes())
+	assert.True(t, store.Has(cmttypes.OracleVoteDataHash(strings.Repeat("d", 1024))))
+
+	// our own data received back from a peer is still kept
+	assert.True(t, store.AddWithHash(own, strings.Repeat("a", 1024)))
+	assert.True(t, store.AddWithHash(cmttypes.OracleVoteDataHash(strings.Repeat("e", 1024)), strings.Repeat("e", 1024)))
+	assert.True(t, store.Has(own))
+
+	// it is only removed once pruned
+	store.Prune(time.Now().Add(time.Second))
+	assert.False(t, store.Has(own))
+}
+
+func TestVoteDataStoreFillData(t *testing.T) {
+	store := NewVoteDataStore(0, nil)
+
+	data := strings.Repeat("x", 1024)
+	known := &oracleproto.Vote{OracleId: "a", DataHash: store.Add(data)}
+	unknown := &oracleproto.Vote{OracleId: "b", DataHash: cmttypes.OracleVoteDataHash("unknown")}
+	inline := &oracleproto.Vote{OracleId: "c", Data: "1"}
+	batch := &oracleproto.GossipedVotes{Votes: []*oracleproto.Vote{known, unknown, inline}}
+
+	assert.Equal(t, [][]byte{unknown.DataHash}, store.MissingData(batch.Votes))
+
+	filled := store.FillData([]*oracleproto.GossipedVotes{batch})
+	assert.Len(t, filled, 1)
+	assert.Equal(t, data, filled[0].Votes[0].Data)
+	assert.Equal(t, "", filled[0].Votes[1].Data)
+	assert.Equal(t, inline, filled[0].Votes[2])
+
+	// the original batch is untouched and still commits to the same votes hash
+	assert.Equal(t, "", batch.Votes[0].Data)
+	assert.Equal(t, cmttypes.OracleVotesHash(batch.Votes), cmttypes.OracleVotesHash(filled[0].Votes))
+	assert.NoError(t, cmttypes.ValidateOracleVoteData(filled[0].Votes[0]))
+}
+
+func TestVoteDataStoreFillCompleteData(t *testing.T) {
+	store := NewVoteDataStore(0, nil)
+
+	known := &oracleproto.Vote{OracleId: "a", DataHash: store.Add("known")}
+	unknown := &oracleproto.Vote{OracleId: "b", DataHash: cmttypes.OracleVoteDataHash("unknown")}
+	inline := &oracleproto.Vote{OracleId: "c", Data: "1"}
+	complete := &oracleproto.GossipedVotes{BatchSeq: 0, Votes: []*oracleproto.Vote{known, inline}}
+	incomplete := &oracleproto.GossipedVotes{BatchSeq: 1, Votes: []*oracleproto.Vote{known, unknown}}
+
+	// the batch with a vote whose data was never received is left out
+	filled, missing := store.FillCompleteData([]*oracleproto.GossipedVotes{complete, incomplete})
+	assert.Equal(t, 1, missing)
+	require.Len(t, filled, 1)
+	assert.EqualValues(t, 0, filled[0].BatchSeq)
+	assert.Equal(t, "known", filled[0].Votes[0].Data)
+
+	// and is included once its data is received
+	require.True(t, store.AddWithHash(unknown.DataHash, "unknown"))
+	filled, missing = store.FillCompleteData([]*oracleproto.GossipedVotes{complete, incomplete})
+	assert.Zero(t, missing)
+	assert.Len(t, filled, 2)
+}
+
+func TestWithoutUnsignedMetadata(t *testing.T) {
+	plain := &oracleproto.GossipedVotes{BatchSeq: 0}
+	relayed := &oracleproto.GossipedVotes{BatchSeq: 1, RelayHops: []*oracleproto.RelayHop{{NodeId: "node", ReceivedAt: 1}}}
+	described := &oracleproto.GossipedVotes{BatchSeq: 2, SignerMetadata: &oracleproto.SignerMetadata{Moniker: "validator"}}
+
+	stripped := WithoutUnsignedMetadata([]*oracleproto.GossipedVotes{plain, relayed, described})
+	assert.Len(t, stripped, 3)
+	assert.Same(t, plain, stripped[0])
+	assert.Empty(t, stripped[1].RelayHops)
+	assert.EqualValues(t, 1, stripped[1].BatchSeq)
+	assert.Nil(t, stripped[2].SignerMetadata)
+	assert.EqualValues(t, 2, stripped[2].BatchSeq)
+
+	// the original batches keep their metadata
+	assert.Len(t, relayed.RelayHops, 1)
+	assert.Equal(t, "validator", described.SignerMetadata.Moniker)
+}
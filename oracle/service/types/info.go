@@ -1,9 +1,17 @@
 package types
 
 import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	abcitypes "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto"
-	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/oracle/verify"
 	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
 	"github.com/cometbft/cometbft/proxy"
 	"github.com/cometbft/cometbft/types"
@@ -11,29 +19,133 @@ import (
 
 // App struct for app
 type OracleInfo struct {
-	Config             *config.OracleConfig
-	UnsignedVoteBuffer *UnsignedVoteBuffer
-	GossipVoteBuffer   *GossipVoteBuffer
-	SignVotesChan      chan *oracleproto.Vote
-	PubKey             crypto.PubKey
-	PrivValidator      types.PrivValidator
-	StopChannel        chan int
-	ProxyApp           proxy.AppConnConsensus
-	BlockTimestamps    []int64
+	Config        *config.OracleConfig
+	State         *OracleState
+	VoteDataStore *VoteDataStore
+	SignVotesChan chan *oracleproto.Vote
+	PubKey        crypto.PubKey
+	PrivValidator types.PrivValidator
+	ProxyApp      proxy.AppConnConsensus
+	VoteSource    VoteSource
+	Mempool       mempool.Mempool
+	Metrics       *Metrics
+	BatchLatency  *BatchLatency
+	// SyncStatus reports whether the node is still catching up, nil if the
+	// node is always considered caught up
+	SyncStatus SyncStatus
+	// Clock returns the time batches are signed at, votes and their data are
+	// pruned against and the warm-up period is measured by, nil for the
+	// system clock. Tests set it to sign batches deterministically.
+	Clock func() time.Time
+
+	signingPaused atomic.Bool
+	// end of the warm-up period in unix nanoseconds, zero if there is none
+	warmUpEnd atomic.Int64
+}
+
+// VoteSource returns the votes to sign, satisfied by the consensus connection
+// to the app and by ABCI clients connected to a separate feeder process.
+type VoteSource interface {
+	FetchOracleVotes(context.Context, *abcitypes.RequestFetchOracleVotes) (*abcitypes.ResponseFetchOracleVotes, error)
+}
+
+// SyncStatus reports whether the node is still catching up through state sync
+// or block sync, or replaying the consensus WAL, satisfied by
+// *consensus.Reactor.
+type SyncStatus interface {
+	CatchingUp() bool
+}
+
+// CatchingUp returns true if the node is still catching up, in which case
+// votes are queued but not signed.
+func (oracleInfo *OracleInfo) CatchingUp() bool {
+	return oracleInfo.SyncStatus != nil && oracleInfo.SyncStatus.CatchingUp()
+}
+
+// PauseSigning stops the runner from signing new batches of votes. Votes are
+// still fetched from the app and batches from other validators are still
+// relayed.
+func (oracleInfo *OracleInfo) PauseSigning() {
+	oracleInfo.signingPaused.Store(true)
+}
+
+// ResumeSigning resumes signing new batches of votes, including the votes
+// fetched while signing was paused which have not been pruned.
+func (oracleInfo *OracleInfo) ResumeSigning() {
+	oracleInfo.signingPaused.Store(false)
+}
+
+// SigningPaused returns true if signing new batches of votes is paused.
+func (oracleInfo *OracleInfo) SigningPaused() bool {
+	return oracleInfo.signingPaused.Load()
 }
-type GossipVoteBuffer struct {
-	Buffer map[string]*oracleproto.GossipedVotes
-	cmtsync.RWMutex
+
+// Now returns the current time according to the Clock.
+func (oracleInfo *OracleInfo) Now() time.Time {
+	if oracleInfo.Clock == nil {
+		return time.Now()
+	}
+	return oracleInfo.Clock()
+}
+
+// WarmUp starts a warm-up period of the given duration, during which the
+// votes fetched are dropped rather than signed.
+func (oracleInfo *OracleInfo) WarmUp(d time.Duration) {
+	if d <= 0 {
+		oracleInfo.warmUpEnd.Store(0)
+		return
+	}
+	oracleInfo.warmUpEnd.Store(oracleInfo.Now().Add(d).UnixNano())
+}
+
+// WarmingUp returns true during the warm-up period.
+func (oracleInfo *OracleInfo) WarmingUp() bool {
+	return oracleInfo.Now().UnixNano() < oracleInfo.warmUpEnd.Load()
+}
+
+// WithTimeout returns a copy of ctx canceled after the timeout, or without a
+// timeout if it is zero.
+func WithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// ValAddress is the fixed-size address of the key that signed a batch of
+// oracle votes. It is used to key the vote buffers so that lookups do not
+// need to allocate a hex string per access.
+type ValAddress [crypto.AddressSize]byte
+
+// ValAddressFromPubKey derives the ValAddress of the given pubkey.
+func ValAddressFromPubKey(pubKey crypto.PubKey) ValAddress {
+	return ValAddressFromBytes(pubKey.Address())
+}
+
+// ValAddressFromBytes converts an address into a ValAddress. Addresses
+// longer than crypto.AddressSize are truncated.
+func ValAddressFromBytes(addr []byte) ValAddress {
+	var valAddr ValAddress
+	copy(valAddr[:], addr)
+	return valAddr
+}
+
+// String returns the address as upper case hex, matching crypto.Address.
+func (addr ValAddress) String() string {
+	return strings.ToUpper(hex.EncodeToString(addr[:]))
 }
 
-type UnsignedVoteBuffer struct {
-	Buffer []*oracleproto.Vote
-	cmtsync.RWMutex
+// GossipVoteKey identifies a signed batch of votes in the OracleState.
+// A validator may have several batches in the buffer when its votes do not
+// fit in a single batch.
+type GossipVoteKey struct {
+	Address  ValAddress
+	BatchSeq uint32
 }
 
-var MainAccountSigPrefix = []byte{0x00}
-var SubAccountSigPrefix = []byte{0x01}
+var MainAccountSigPrefix = []byte{verify.MainAccountSigPrefix}
+var SubAccountSigPrefix = []byte{verify.SubAccountSigPrefix}
 
-var Ed25519SignType = []byte{0x02}
-var Sr25519SignType = []byte{0x03}
-var Secp256k1SignType = []byte{0x04}
+var Ed25519SignType = []byte{verify.Ed25519SignType}
+var Sr25519SignType = []byte{verify.Sr25519SignType}
+var Secp256k1SignType = []byte{verify.Secp256k1SignType}
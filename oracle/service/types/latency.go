@@ -0,0 +1,68 @@
+package types
+
+import (
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+)
+
+// BatchLatency tracks the latest batch signed by this node, so that the
+// gossip and quorum stages of the vote pipeline can be measured against the
+// time the batch was signed. Each stage is only observed once per batch.
+type BatchLatency struct {
+	mtx      cmtsync.Mutex
+	batch    *oracleproto.GossipedVotes
+	signedAt time.Time
+	sent     bool
+	quorum   bool
+}
+
+// Signed starts tracking a newly signed batch.
+func (bl *BatchLatency) Signed(batch *oracleproto.GossipedVotes, signedAt time.Time) {
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+
+	bl.batch = batch
+	bl.signedAt = signedAt
+	bl.sent = false
+	bl.quorum = false
+}
+
+// MarkSent returns the time elapsed since the batch was signed if this is the
+// first time the tracked batch has been sent to a peer.
+func (bl *BatchLatency) MarkSent(batch *oracleproto.GossipedVotes) (time.Duration, bool) {
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+
+	if bl.batch == nil || bl.batch != batch || bl.sent {
+		return 0, false
+	}
+	bl.sent = true
+	return time.Since(bl.signedAt), true
+}
+
+// PendingQuorum returns the tracked batch if quorum has not been observed for
+// it yet.
+func (bl *BatchLatency) PendingQuorum() *oracleproto.GossipedVotes {
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+
+	if bl.batch == nil || bl.quorum {
+		return nil
+	}
+	return bl.batch
+}
+
+// MarkQuorum returns the time elapsed since the batch was signed if quorum
+// has not been observed for the tracked batch yet.
+func (bl *BatchLatency) MarkQuorum(batch *oracleproto.GossipedVotes) (time.Duration, bool) {
+	bl.mtx.Lock()
+	defer bl.mtx.Unlock()
+
+	if bl.batch == nil || bl.batch != batch || bl.quorum {
+		return 0, false
+	}
+	bl.quorum = true
+	return time.Since(bl.signedAt), true
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+)
+
+func TestBatchLatency(t *testing.T) {
+	bl := &BatchLatency{}
+	batch := &oracleproto.GossipedVotes{SignedTimestamp: 1}
+
+	// nothing tracked yet
+	_, ok := bl.MarkSent(batch)
+	assert.False(t, ok)
+	assert.Nil(t, bl.PendingQuorum())
+
+	bl.Signed(batch, time.Now())
+	assert.Equal(t, batch, bl.PendingQuorum())
+
+	// each stage is only observed once per batch
+	_, ok = bl.MarkSent(batch)
+	assert.True(t, ok)
+	_, ok = bl.MarkSent(batch)
+	assert.False(t, ok)
+
+	_, ok = bl.MarkQuorum(batch)
+	assert.True(t, ok)
+	assert.Nil(t, bl.PendingQuorum())
+
+	// batches other than the tracked one are ignored
+	newBatch := &oracleproto.GossipedVotes{SignedTimestamp: 2}
+	bl.Signed(newBatch, time.Now())
+	_, ok = bl.MarkSent(batch)
+	assert.False(t, ok)
+	_, ok = bl.MarkQuorum(batch)
+	assert.False(t, ok)
+}
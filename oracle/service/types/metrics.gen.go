@@ -0,0 +1,148 @@
+// Code generated by metricsgen. DO NOT EDIT.
+
+package types
+
+import (
+	"github.com/go-kit/kit/metrics/discard"
+	prometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		VoteLatencySeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "vote_latency_seconds",
+			Help:      "Histogram of the time spent in each stage of the oracle vote pipeline. fetch: time taken by the app to return a vote. sign: time taken to sign a batch of votes. gossip: time from a batch being signed to it being first sent to a peer. quorum: time from a batch being signed to batches at least as recent being observed from validators holding more than 2/3 of the voting power.",
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.001, 30, 12),
+		}, append(labels, "stage")).With(labelsAndValues...),
+		DuplicateVotes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "duplicate_votes",
+			Help:      "Number of votes fetched from the app that were dropped for having the same oracle ID and timestamp as a vote waiting to be signed, which is replaced so that the latest data is signed.",
+		}, labels).With(labelsAndValues...),
+		OverflowVotes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "overflow_votes",
+			Help:      "Number of votes fetched from the app that were dropped as the oracle already had the max number of votes waiting to be signed, e.g. as its adapter produces votes far more often than they can be aggregated.",
+		}, append(labels, "oracle_id")).With(labelsAndValues...),
+		OversizedVotes: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "oversized_votes",
+			Help:      "Number of votes that were dropped without being signed for being too large to be gossiped, in a batch of their own or, for votes gossiped by data hash, in a response on the oracle data channel.",
+		}, append(labels, "oracle_id")).With(labelsAndValues...),
+		SignQueueDepth: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "sign_queue_depth",
+			Help:      "Number of fetched votes waiting to be signed.",
+		}, labels).With(labelsAndValues...),
+		FetchThrottles: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "fetch_throttles",
+			Help:      "Number of times fetching votes was paused as the number of votes waiting to be signed reached the high watermark.",
+		}, labels).With(labelsAndValues...),
+		PeerPingRTTSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_ping_rttseconds",
+			Help:      "Histogram of the round trip time of the pings sent to each peer on the oracle channels.",
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.001, 10, 10),
+		}, append(labels, "peer_id")).With(labelsAndValues...),
+		ProbePropagationSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "probe_propagation_seconds",
+			Help:      "Histogram of the time the probes sent by each node took to propagate to this node across the oracle mesh, as measured against the clock of the origin.",
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.001, 10, 10),
+		}, append(labels, "origin_id")).With(labelsAndValues...),
+		SkippedPeers: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "skipped_peers",
+			Help:      "Number of peers that votes are not gossiped to, as they did not advertise the oracle channel.",
+		}, labels).With(labelsAndValues...),
+		LegacyBatches: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "legacy_batches",
+			Help:      "Number of batches of votes dropped as they are signed in the legacy form, by peers predating the signing over the merkle root of the votes.",
+		}, labels).With(labelsAndValues...),
+		SendFailures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "send_failures",
+			Help:      "Number of batches of votes that could not be queued for sending to a peer, as its send queue was full.",
+		}, labels).With(labelsAndValues...),
+		LastSeenTimestamp: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "last_seen_timestamp",
+			Help:      "Unix time of the latest batch of votes or heartbeat received from each signer, for tracking validator downtime.",
+		}, append(labels, "validator_address")).With(labelsAndValues...),
+		PeerMisbehavior: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_misbehavior",
+			Help:      "Number of times a peer misbehaved on the oracle channels, by reason, e.g. by relaying batches of votes with an invalid signature.",
+		}, append(labels, "peer_id", "reason")).With(labelsAndValues...),
+		GossipBufferBytes: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "gossip_buffer_bytes",
+			Help:      "Encoded size of the gossiped batches of votes kept in memory.",
+		}, labels).With(labelsAndValues...),
+		VoteDataBytes: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "vote_data_bytes",
+			Help:      "Size of the data of votes gossiped by data hash kept in memory.",
+		}, labels).With(labelsAndValues...),
+		ConsensusStressed: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "consensus_stressed",
+			Help:      "Whether votes are gossiped at a lower pace as consensus is stressed, 1 if so and 0 otherwise.",
+		}, labels).With(labelsAndValues...),
+		RoutineRestarts: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "routine_restarts",
+			Help:      "Number of times an oracle routine was restarted after panicking.",
+		}, append(labels, "routine")).With(labelsAndValues...),
+	}
+}
+
+func NopMetrics() *Metrics {
+	return &Metrics{
+		VoteLatencySeconds:      discard.NewHistogram(),
+		DuplicateVotes:          discard.NewCounter(),
+		OverflowVotes:           discard.NewCounter(),
+		OversizedVotes:          discard.NewCounter(),
+		SignQueueDepth:          discard.NewGauge(),
+		FetchThrottles:          discard.NewCounter(),
+		PeerPingRTTSeconds:      discard.NewHistogram(),
+		ProbePropagationSeconds: discard.NewHistogram(),
+		SkippedPeers:            discard.NewCounter(),
+		LegacyBatches:           discard.NewCounter(),
+		SendFailures:            discard.NewCounter(),
+		LastSeenTimestamp:       discard.NewGauge(),
+		PeerMisbehavior:         discard.NewCounter(),
+		GossipBufferBytes:       discard.NewGauge(),
+		VoteDataBytes:           discard.NewGauge(),
+		ConsensusStressed:       discard.NewGauge(),
+		RoutineRestarts:         discard.NewCounter(),
+	}
+}
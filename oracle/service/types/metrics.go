@@ -0,0 +1,88 @@
+package types
+
+import (
+	"github.com/go-kit/kit/metrics"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "oracle"
+)
+
+//go:generate go run ../../../scripts/metricsgen -struct=Metrics
+
+// Metrics contains metrics exposed by the oracle service.
+type Metrics struct {
+	// Histogram of the time spent in each stage of the oracle vote pipeline.
+	// fetch: time taken by the app to return a vote.
+	// sign: time taken to sign a batch of votes.
+	// gossip: time from a batch being signed to it being first sent to a peer.
+	// quorum: time from a batch being signed to batches at least as recent
+	// being observed from validators holding more than 2/3 of the voting power.
+	VoteLatencySeconds metrics.Histogram `metrics_labels:"stage" metrics_buckettype:"exprange" metrics_bucketsizes:"0.001, 30, 12"`
+
+	// Number of votes fetched from the app that were dropped for having the
+	// same oracle ID and timestamp as a vote waiting to be signed, which is
+	// replaced so that the latest data is signed.
+	DuplicateVotes metrics.Counter
+
+	// Number of votes fetched from the app that were dropped as the oracle
+	// already had the max number of votes waiting to be signed, e.g. as its
+	// adapter produces votes far more often than they can be aggregated.
+	OverflowVotes metrics.Counter `metrics_labels:"oracle_id"`
+
+	// Number of votes that were dropped without being signed for being too
+	// large to be gossiped, in a batch of their own or, for votes gossiped by
+	// data hash, in a response on the oracle data channel.
+	OversizedVotes metrics.Counter `metrics_labels:"oracle_id"`
+
+	// Number of fetched votes waiting to be signed.
+	SignQueueDepth metrics.Gauge
+
+	// Number of times fetching votes was paused as the number of votes
+	// waiting to be signed reached the high watermark.
+	FetchThrottles metrics.Counter
+
+	// Histogram of the round trip time of the pings sent to each peer on the
+	// oracle channels.
+	PeerPingRTTSeconds metrics.Histogram `metrics_labels:"peer_id" metrics_buckettype:"exprange" metrics_bucketsizes:"0.001, 10, 10"`
+
+	// Histogram of the time the probes sent by each node took to propagate
+	// to this node across the oracle mesh, as measured against the clock of
+	// the origin.
+	ProbePropagationSeconds metrics.Histogram `metrics_labels:"origin_id" metrics_buckettype:"exprange" metrics_bucketsizes:"0.001, 10, 10"`
+
+	// Number of peers that votes are not gossiped to, as they did not
+	// advertise the oracle channel.
+	SkippedPeers metrics.Counter
+
+	// Number of batches of votes dropped as they are signed in the legacy
+	// form, by peers predating the signing over the merkle root of the votes.
+	LegacyBatches metrics.Counter
+
+	// Number of batches of votes that could not be queued for sending to a
+	// peer, as its send queue was full.
+	SendFailures metrics.Counter
+
+	// Unix time of the latest batch of votes or heartbeat received from each
+	// signer, for tracking validator downtime.
+	LastSeenTimestamp metrics.Gauge `metrics_labels:"validator_address"`
+
+	// Number of times a peer misbehaved on the oracle channels, by reason,
+	// e.g. by relaying batches of votes with an invalid signature.
+	PeerMisbehavior metrics.Counter `metrics_labels:"peer_id, reason"`
+
+	// Encoded size of the gossiped batches of votes kept in memory.
+	GossipBufferBytes metrics.Gauge
+
+	// Size of the data of votes gossiped by data hash kept in memory.
+	VoteDataBytes metrics.Gauge
+
+	// Whether votes are gossiped at a lower pace as consensus is stressed,
+	// 1 if so and 0 otherwise.
+	ConsensusStressed metrics.Gauge
+
+	// Number of times an oracle routine was restarted after panicking.
+	RoutineRestarts metrics.Counter `metrics_labels:"routine"`
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+)
+
+// Snapshot is a deep copy of the oracle buffers at a point in time. It can be
+// read, modified and serialized, e.g. by RPC or metrics consumers, without
+// holding the buffer locks and without racing with updates to the buffers.
+type Snapshot struct {
+	UnsignedVotes []*oracleproto.Vote
+	Batches       map[GossipVoteKey]*oracleproto.GossipedVotes
+	Heartbeats    []*oracleproto.GossipedVotes
+	GossipBytes   int
+}
+
+// Snapshot returns a deep copy of the oracle buffers. The buffers are only
+// locked while gathering their entries, which are never modified once added,
+// so the entries are copied without holding any lock.
+func (oracleInfo *OracleInfo) Snapshot() *Snapshot {
+	state := oracleInfo.State
+	unsigned := state.UnsignedVotes()
+	batches := state.Batches()
+	heartbeats := state.Heartbeats()
+
+	snapshot := &Snapshot{
+		UnsignedVotes: make([]*oracleproto.Vote, len(unsigned)),
+		Batches:       make(map[GossipVoteKey]*oracleproto.GossipedVotes, len(batches)),
+		Heartbeats:    make([]*oracleproto.GossipedVotes, len(heartbeats)),
+		GossipBytes:   state.GossipBytes(),
+	}
+	for i, vote := range unsigned {
+		snapshot.UnsignedVotes[i] = proto.Clone(vote).(*oracleproto.Vote)
+	}
+	for key, batch := range batches {
+		snapshot.Batches[key] = proto.Clone(batch).(*oracleproto.GossipedVotes)
+	}
+	for i, heartbeat := range heartbeats {
+		snapshot.Heartbeats[i] = proto.Clone(heartbeat).(*oracleproto.GossipedVotes)
+	}
+	return snapshot
+}
+
+// CurrentBatches returns the batches of the snapshot, skipping batches that
+// have been superseded by a more recently signed batch from the same signer.
+func (snapshot *Snapshot) CurrentBatches() []*oracleproto.GossipedVotes {
+	latest := latestSignedTimestamps(snapshot.Batches)
+	batches := make([]*oracleproto.GossipedVotes, 0, len(snapshot.Batches))
+	for key, batch := range snapshot.Batches {
+		if batch.SignedTimestamp < latest[key.Address] {
+			continue
+		}
+		batches = append(batches, batch)
+	}
+	return batches
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+)
+
+func TestOracleInfoSnapshot(t *testing.T) {
+	oracleInfo := &OracleInfo{State: NewOracleState(0, 0)}
+
+	vote := &oracleproto.Vote{OracleId: "a", Timestamp: 10, Data: "1"}
+	oracleInfo.State.AddUnsigned(vote)
+	val := ValAddress{0x01}
+	batch := &oracleproto.GossipedVotes{SignedTimestamp: 10, Votes: []*oracleproto.Vote{{OracleId: "a", Timestamp: 10, Data: "1"}}}
+	require.True(t, oracleInfo.State.MergeGossip(val, batch))
+	heartbeat := &oracleproto.GossipedVotes{SignedTimestamp: 10}
+	require.True(t, oracleInfo.State.MergeHeartbeat(val, heartbeat))
+
+	snapshot := oracleInfo.Snapshot()
+	require.Len(t, snapshot.UnsignedVotes, 1)
+	assert.Equal(t, vote, snapshot.UnsignedVotes[0])
+	assert.NotSame(t, vote, snapshot.UnsignedVotes[0])
+	key := GossipVoteKey{Address: val}
+	assert.Equal(t, batch, snapshot.Batches[key])
+	assert.Equal(t, []*oracleproto.GossipedVotes{heartbeat}, snapshot.Heartbeats)
+	assert.Equal(t, batch.Size(), snapshot.GossipBytes)
+
+	// modifying the snapshot leaves the buffers untouched
+	snapshot.UnsignedVotes[0].Data = "2"
+	snapshot.Batches[key].Votes[0].Data = "2"
+	assert.Equal(t, "1", oracleInfo.State.UnsignedVotes()[0].Data)
+	assert.Equal(t, "1", oracleInfo.State.CurrentBatches()[0].Votes[0].Data)
+
+	// superseded batches are skipped, as by the buffers
+	require.True(t, oracleInfo.State.MergeGossip(val, &oracleproto.GossipedVotes{SignedTimestamp: 11, BatchSeq: 1}))
+	assert.ElementsMatch(t, oracleInfo.State.CurrentBatches(), oracleInfo.Snapshot().CurrentBatches())
+	assert.Len(t, oracleInfo.Snapshot().CurrentBatches(), 1)
+}
+
+func TestOracleInfoSnapshotConcurrentUpdates(t *testing.T) {
+	oracleInfo := &OracleInfo{State: NewOracleState(0, 0)}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := int64(1); i <= 100; i++ {
+			oracleInfo.State.AddUnsigned(&oracleproto.Vote{OracleId: "a", Timestamp: i})
+			oracleInfo.State.MergeGossip(ValAddress{0x01}, &oracleproto.GossipedVotes{SignedTimestamp: i})
+			oracleInfo.State.Prune(i-10, func(string) bool { return false })
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		snapshot := oracleInfo.Snapshot()
+		for _, batch := range snapshot.Batches {
+			_, err := batch.Marshal()
+			require.NoError(t, err)
+		}
+	}
+	wg.Wait()
+}
@@ -0,0 +1,413 @@
+package types
+
+import (
+	"fmt"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+)
+
+// OracleState holds the votes waiting to be signed, the signed batches of
+// votes gossiped between validators, the latest heartbeat of each validator
+// and the timestamps of the latest blocks, which bound how long votes are
+// kept. It is safe for concurrent use.
+type OracleState struct {
+	unsignedMtx          cmtsync.Mutex
+	unsigned             []*oracleproto.Vote
+	maxUnsignedPerOracle int
+
+	gossipMtx      cmtsync.RWMutex
+	gossip         map[GossipVoteKey]*oracleproto.GossipedVotes
+	gossipBytes    int
+	maxGossipBytes int
+	// batches signed by the local validator are never evicted
+	localAddr *ValAddress
+
+	heartbeatsMtx cmtsync.RWMutex
+	heartbeats    map[ValAddress]*oracleproto.GossipedVotes
+
+	updatedMtx cmtsync.Mutex
+	updated    chan struct{}
+
+	timestampsMtx   cmtsync.RWMutex
+	blockTimestamps []int64
+}
+
+// NewOracleState returns an empty OracleState. When the encoded size of the
+// gossiped batches exceeds maxGossipBytes, the oldest batches of other
+// validators are evicted, and at most maxUnsignedPerOracle votes of each
+// oracle are kept waiting to be signed. Zero does not bound either.
+func NewOracleState(maxGossipBytes, maxUnsignedPerOracle int) *OracleState {
+	return &OracleState{
+		unsigned:             []*oracleproto.Vote{},
+		maxUnsignedPerOracle: maxUnsignedPerOracle,
+		gossip:               make(map[GossipVoteKey]*oracleproto.GossipedVotes),
+		maxGossipBytes:       maxGossipBytes,
+		heartbeats:           make(map[ValAddress]*oracleproto.GossipedVotes),
+	}
+}
+
+// AddUnsigned adds votes waiting to be signed and returns all the votes
+// waiting to be signed, along with the number of duplicate votes dropped and
+// the number of votes dropped per oracle ID for exceeding the votes of an
+// oracle that can wait to be signed.
+// A vote with the same key as a vote already waiting to be signed, such as a
+// vote resent by the app after a restart, replaces it so that the latest data
+// is signed.
+func (s *OracleState) AddUnsigned(votes ...*oracleproto.Vote) ([]*oracleproto.Vote, int, map[string]int) {
+	s.unsignedMtx.Lock()
+	defer s.unsignedMtx.Unlock()
+
+	duplicates := 0
+	var overflow map[string]int
+	if len(votes) > 0 {
+		indices := make(map[string]int, len(s.unsigned))
+		perOracle := make(map[string]int)
+		for idx, vote := range s.unsigned {
+			indices[UnsignedVoteKey(vote)] = idx
+			perOracle[vote.OracleId]++
+		}
+		for _, vote := range votes {
+			key := UnsignedVoteKey(vote)
+			if idx, ok := indices[key]; ok {
+				s.unsigned[idx] = vote
+				duplicates++
+				continue
+			}
+			if s.maxUnsignedPerOracle > 0 && perOracle[vote.OracleId] >= s.maxUnsignedPerOracle {
+				if overflow == nil {
+					overflow = make(map[string]int)
+				}
+				overflow[vote.OracleId]++
+				continue
+			}
+			indices[key] = len(s.unsigned)
+			perOracle[vote.OracleId]++
+			s.unsigned = append(s.unsigned, vote)
+		}
+	}
+	return append([]*oracleproto.Vote{}, s.unsigned...), duplicates, overflow
+}
+
+// UnsignedVotes returns the votes waiting to be signed.
+func (s *OracleState) UnsignedVotes() []*oracleproto.Vote {
+	s.unsignedMtx.Lock()
+	defer s.unsignedMtx.Unlock()
+
+	return append([]*oracleproto.Vote{}, s.unsigned...)
+}
+
+// RemoveUnsigned removes the votes waiting to be signed with the same key as
+// the given votes, e.g. votes which can't be signed.
+func (s *OracleState) RemoveUnsigned(votes ...*oracleproto.Vote) {
+	if len(votes) == 0 {
+		return
+	}
+	remove := make(map[string]struct{}, len(votes))
+	for _, vote := range votes {
+		remove[UnsignedVoteKey(vote)] = struct{}{}
+	}
+
+	s.unsignedMtx.Lock()
+	defer s.unsignedMtx.Unlock()
+
+	kept := make([]*oracleproto.Vote, 0, len(s.unsigned))
+	for _, vote := range s.unsigned {
+		if _, ok := remove[UnsignedVoteKey(vote)]; !ok {
+			kept = append(kept, vote)
+		}
+	}
+	s.unsigned = kept
+}
+
+// SealBatch replaces all the batches signed by addr with the given batches,
+// which are signed together and cover all of its votes.
+func (s *OracleState) SealBatch(addr ValAddress, batches []*oracleproto.GossipedVotes) {
+	s.gossipMtx.Lock()
+	s.localAddr = &addr
+	for seq := uint32(0); ; seq++ {
+		key := GossipVoteKey{Address: addr, BatchSeq: seq}
+		if _, ok := s.gossip[key]; !ok {
+			break
+		}
+		s.deleteBatch(key)
+	}
+	for _, batch := range batches {
+		s.setBatch(GossipVoteKey{Address: addr, BatchSeq: batch.BatchSeq}, batch)
+	}
+	s.evictBatches()
+	s.gossipMtx.Unlock()
+
+	s.notifyUpdated()
+}
+
+// MergeGossip adds a batch signed by addr and received from a peer, unless
+// a batch with the same sequence number signed at least as recently is
+// already present. It returns true if the batch was added and not evicted
+// right away for being the oldest batch over the size bound.
+func (s *OracleState) MergeGossip(addr ValAddress, batch *oracleproto.GossipedVotes) bool {
+	key := GossipVoteKey{Address: addr, BatchSeq: batch.BatchSeq}
+
+	s.gossipMtx.Lock()
+	current, ok := s.gossip[key]
+	added := !ok || batch.SignedTimestamp > current.SignedTimestamp
+	if added {
+		s.setBatch(key, batch)
+		s.evictBatches()
+		_, added = s.gossip[key]
+	}
+	s.gossipMtx.Unlock()
+
+	if added {
+		s.notifyUpdated()
+	}
+	return added
+}
+
+// Batches returns all the batches, keyed by signer and sequence number.
+func (s *OracleState) Batches() map[GossipVoteKey]*oracleproto.GossipedVotes {
+	s.gossipMtx.RLock()
+	defer s.gossipMtx.RUnlock()
+
+	batches := make(map[GossipVoteKey]*oracleproto.GossipedVotes, len(s.gossip))
+	for key, batch := range s.gossip {
+		batches[key] = batch
+	}
+	return batches
+}
+
+// Batch returns the batch with the given key, if any.
+func (s *OracleState) Batch(key GossipVoteKey) (*oracleproto.GossipedVotes, bool) {
+	s.gossipMtx.RLock()
+	defer s.gossipMtx.RUnlock()
+
+	batch, ok := s.gossip[key]
+	return batch, ok
+}
+
+// CurrentBatches returns the batches, skipping batches that have been
+// superseded by a more recently signed batch from the same signer.
+func (s *OracleState) CurrentBatches() []*oracleproto.GossipedVotes {
+	s.gossipMtx.RLock()
+	defer s.gossipMtx.RUnlock()
+
+	latest := latestSignedTimestamps(s.gossip)
+	batches := make([]*oracleproto.GossipedVotes, 0, len(s.gossip))
+	for key, batch := range s.gossip {
+		if batch.SignedTimestamp < latest[key.Address] {
+			continue
+		}
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// MergeHeartbeat sets the heartbeat signed by addr, a batch without votes
+// published by validators with nothing to vote on, unless a heartbeat signed
+// at least as recently is already present. Heartbeats are kept apart from the
+// batches of votes. It returns true if the heartbeat was set.
+func (s *OracleState) MergeHeartbeat(addr ValAddress, heartbeat *oracleproto.GossipedVotes) bool {
+	s.heartbeatsMtx.Lock()
+	current, ok := s.heartbeats[addr]
+	added := !ok || heartbeat.SignedTimestamp > current.SignedTimestamp
+	if added {
+		s.heartbeats[addr] = heartbeat
+	}
+	s.heartbeatsMtx.Unlock()
+
+	if added {
+		s.notifyUpdated()
+	}
+	return added
+}
+
+// Heartbeats returns the latest heartbeat of each validator.
+func (s *OracleState) Heartbeats() []*oracleproto.GossipedVotes {
+	s.heartbeatsMtx.RLock()
+	defer s.heartbeatsMtx.RUnlock()
+
+	heartbeats := make([]*oracleproto.GossipedVotes, 0, len(s.heartbeats))
+	for _, heartbeat := range s.heartbeats {
+		heartbeats = append(heartbeats, heartbeat)
+	}
+	return heartbeats
+}
+
+// RemoveBatches removes the batches for which remove returns true and
+// returns the number of batches removed.
+func (s *OracleState) RemoveBatches(remove func(GossipVoteKey, *oracleproto.GossipedVotes) bool) int {
+	s.gossipMtx.Lock()
+	defer s.gossipMtx.Unlock()
+
+	removed := 0
+	for key, batch := range s.gossip {
+		if remove(key, batch) {
+			s.deleteBatch(key)
+			removed++
+		}
+	}
+	return removed
+}
+
+// GossipBytes returns the encoded size of all the batches.
+func (s *OracleState) GossipBytes() int {
+	s.gossipMtx.RLock()
+	defer s.gossipMtx.RUnlock()
+
+	return s.gossipBytes
+}
+
+// setBatch adds or replaces the batch with the given key, keeping track of
+// the size of the batches. The caller must hold gossipMtx.
+func (s *OracleState) setBatch(key GossipVoteKey, batch *oracleproto.GossipedVotes) {
+	s.deleteBatch(key)
+	s.gossip[key] = batch
+	s.gossipBytes += batch.Size()
+}
+
+// deleteBatch removes the batch with the given key, if any, keeping track of
+// the size of the batches. The caller must hold gossipMtx.
+func (s *OracleState) deleteBatch(key GossipVoteKey) {
+	if batch, ok := s.gossip[key]; ok {
+		delete(s.gossip, key)
+		s.gossipBytes -= batch.Size()
+	}
+}
+
+// evictBatches removes the oldest batches of other validators until the
+// batches fit within maxGossipBytes. The caller must hold gossipMtx.
+func (s *OracleState) evictBatches() {
+	if s.maxGossipBytes <= 0 {
+		return
+	}
+	for s.gossipBytes > s.maxGossipBytes {
+		var (
+			oldestKey GossipVoteKey
+			oldest    *oracleproto.GossipedVotes
+		)
+		for key, batch := range s.gossip {
+			if s.localAddr != nil && key.Address == *s.localAddr {
+				continue
+			}
+			if oldest == nil || batch.SignedTimestamp < oldest.SignedTimestamp {
+				oldestKey, oldest = key, batch
+			}
+		}
+		if oldest == nil {
+			return
+		}
+		s.deleteBatch(oldestKey)
+	}
+}
+
+// Prune removes the unsigned votes, batches and heartbeats older than
+// latestAllowableTimestamp, as well as unsigned votes for which resultExists
+// returns true and superseded batches.
+// resultExists is called without holding any lock. Prune must not be called
+// concurrently with itself.
+func (s *OracleState) Prune(latestAllowableTimestamp int64, resultExists func(key string) bool) {
+	pruned := make(map[string]struct{})
+	for _, vote := range s.UnsignedVotes() {
+		key := UnsignedVoteKey(vote)
+		if vote.Timestamp < latestAllowableTimestamp || resultExists(key) {
+			pruned[key] = struct{}{}
+		}
+	}
+
+	// the votes are removed by key, as votes may have been added, replaced by
+	// a duplicate or removed while pruning
+	s.unsignedMtx.Lock()
+	kept := make([]*oracleproto.Vote, 0, len(s.unsigned))
+	for _, vote := range s.unsigned {
+		if _, ok := pruned[UnsignedVoteKey(vote)]; !ok {
+			kept = append(kept, vote)
+		}
+	}
+	s.unsigned = kept
+	s.unsignedMtx.Unlock()
+
+	s.gossipMtx.Lock()
+	latest := latestSignedTimestamps(s.gossip)
+	for key, batch := range s.gossip {
+		if batch.SignedTimestamp < latestAllowableTimestamp || batch.SignedTimestamp < latest[key.Address] {
+			s.deleteBatch(key)
+		}
+	}
+	s.gossipMtx.Unlock()
+
+	s.heartbeatsMtx.Lock()
+	for addr, heartbeat := range s.heartbeats {
+		if heartbeat.SignedTimestamp < latestAllowableTimestamp {
+			delete(s.heartbeats, addr)
+		}
+	}
+	s.heartbeatsMtx.Unlock()
+}
+
+// UnsignedVoteKey returns the key identifying duplicate unsigned votes, which
+// is also the key the app uses to look up committed results.
+func UnsignedVoteKey(vote *oracleproto.Vote) string {
+	return fmt.Sprintf("%v:%v", vote.Timestamp, vote.OracleId)
+}
+
+func latestSignedTimestamps(batches map[GossipVoteKey]*oracleproto.GossipedVotes) map[ValAddress]int64 {
+	latest := make(map[ValAddress]int64)
+	for key, batch := range batches {
+		if batch.SignedTimestamp > latest[key.Address] {
+			latest[key.Address] = batch.SignedTimestamp
+		}
+	}
+	return latest
+}
+
+// Updated returns a channel that is closed the next time new batches or
+// heartbeats are added.
+func (s *OracleState) Updated() <-chan struct{} {
+	s.updatedMtx.Lock()
+	defer s.updatedMtx.Unlock()
+
+	if s.updated == nil {
+		s.updated = make(chan struct{})
+	}
+	return s.updated
+}
+
+// notifyUpdated wakes up everyone waiting on Updated.
+func (s *OracleState) notifyUpdated() {
+	s.updatedMtx.Lock()
+	defer s.updatedMtx.Unlock()
+
+	if s.updated != nil {
+		close(s.updated)
+		s.updated = nil
+	}
+}
+
+// RecordBlockTimestamp records the timestamp of the latest block, keeping the
+// timestamps of at most maxBlocks blocks.
+func (s *OracleState) RecordBlockTimestamp(timestamp int64, maxBlocks int) {
+	s.timestampsMtx.Lock()
+	defer s.timestampsMtx.Unlock()
+
+	if n := len(s.blockTimestamps); n > 0 && s.blockTimestamps[n-1] == timestamp {
+		return
+	}
+	s.blockTimestamps = append(s.blockTimestamps, timestamp)
+	if len(s.blockTimestamps) > maxBlocks {
+		s.blockTimestamps = s.blockTimestamps[len(s.blockTimestamps)-maxBlocks:]
+	}
+}
+
+// LatestAllowableTimestamp returns the timestamp before which votes are no
+// longer kept nor gossiped, which is the max(earliest of the last maxBlocks
+// block timestamps, now - maxAge).
+func (s *OracleState) LatestAllowableTimestamp(now int64, maxAge int, maxBlocks int) int64 {
+	s.timestampsMtx.RLock()
+	defer s.timestampsMtx.RUnlock()
+
+	latestAllowableTimestamp := now - int64(maxAge)
+	if len(s.blockTimestamps) == maxBlocks && s.blockTimestamps[0] > latestAllowableTimestamp {
+		latestAllowableTimestamp = s.blockTimestamps[0]
+	}
+	return latestAllowableTimestamp
+}
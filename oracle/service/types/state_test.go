@@ -0,0 +1,279 @@
+package types
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+)
+
+func TestOracleStateCurrentBatches(t *testing.T) {
+	valA := ValAddress{0x01}
+	valB := ValAddress{0x02}
+
+	current := []*oracleproto.GossipedVotes{
+		{SignedTimestamp: 20, BatchSeq: 0},
+		{SignedTimestamp: 20, BatchSeq: 1},
+		{SignedTimestamp: 10, BatchSeq: 0},
+	}
+	superseded := &oracleproto.GossipedVotes{SignedTimestamp: 15, BatchSeq: 2}
+
+	state := NewOracleState(0, 0)
+	assert.True(t, state.MergeGossip(valA, superseded))
+	assert.True(t, state.MergeGossip(valA, current[0]))
+	assert.True(t, state.MergeGossip(valA, current[1]))
+	assert.True(t, state.MergeGossip(valB, current[2]))
+
+	assert.ElementsMatch(t, current, state.CurrentBatches())
+	assert.Len(t, state.Batches(), 4)
+}
+
+func TestOracleStateMergeGossip(t *testing.T) {
+	val := ValAddress{0x01}
+	state := NewOracleState(0, 0)
+
+	newer := &oracleproto.GossipedVotes{SignedTimestamp: 20}
+	assert.True(t, state.MergeGossip(val, newer))
+	assert.False(t, state.MergeGossip(val, &oracleproto.GossipedVotes{SignedTimestamp: 10}))
+	assert.False(t, state.MergeGossip(val, &oracleproto.GossipedVotes{SignedTimestamp: 20}))
+	assert.Equal(t, []*oracleproto.GossipedVotes{newer}, state.CurrentBatches())
+}
+
+func TestOracleStateMergeHeartbeat(t *testing.T) {
+	valA := ValAddress{0x01}
+	valB := ValAddress{0x02}
+	state := NewOracleState(0, 0)
+
+	newer := &oracleproto.GossipedVotes{SignedTimestamp: 20}
+	assert.True(t, state.MergeHeartbeat(valA, &oracleproto.GossipedVotes{SignedTimestamp: 10}))
+	assert.True(t, state.MergeHeartbeat(valA, newer))
+	assert.False(t, state.MergeHeartbeat(valA, &oracleproto.GossipedVotes{SignedTimestamp: 15}))
+	assert.Equal(t, []*oracleproto.GossipedVotes{newer}, state.Heartbeats())
+
+	// heartbeats are not batches of votes, and are pruned like them
+	assert.True(t, state.MergeHeartbeat(valB, &oracleproto.GossipedVotes{SignedTimestamp: 5}))
+	assert.Empty(t, state.Batches())
+	state.Prune(10, func(string) bool { return false })
+	assert.Equal(t, []*oracleproto.GossipedVotes{newer}, state.Heartbeats())
+}
+
+func TestOracleStateSealBatch(t *testing.T) {
+	val := ValAddress{0x01}
+	state := NewOracleState(0, 0)
+
+	state.SealBatch(val, []*oracleproto.GossipedVotes{
+		{SignedTimestamp: 10, BatchSeq: 0},
+		{SignedTimestamp: 10, BatchSeq: 1},
+		{SignedTimestamp: 10, BatchSeq: 2},
+	})
+	assert.Len(t, state.Batches(), 3)
+
+	// the new batches replace all previous batches, even if there are fewer of them
+	sealed := []*oracleproto.GossipedVotes{{SignedTimestamp: 20, BatchSeq: 0}}
+	state.SealBatch(val, sealed)
+	assert.Equal(t, sealed, state.CurrentBatches())
+	assert.Len(t, state.Batches(), 1)
+}
+
+func TestOracleStateAddUnsigned(t *testing.T) {
+	state := NewOracleState(0, 0)
+
+	a := &oracleproto.Vote{OracleId: "a", Timestamp: 10, Data: "1"}
+	b := &oracleproto.Vote{OracleId: "b", Timestamp: 10, Data: "1"}
+	votes, duplicates, _ := state.AddUnsigned(a, b)
+	assert.Equal(t, []*oracleproto.Vote{a, b}, votes)
+	assert.Zero(t, duplicates)
+
+	// duplicates replace the vote in place, keeping the latest data, whether
+	// they are added along with the vote or later
+	resent := &oracleproto.Vote{OracleId: "a", Timestamp: 10, Data: "2"}
+	latest := &oracleproto.Vote{OracleId: "a", Timestamp: 10, Data: "3"}
+	next := &oracleproto.Vote{OracleId: "a", Timestamp: 11, Data: "1"}
+	votes, duplicates, _ = state.AddUnsigned(resent, next, latest)
+	assert.Equal(t, []*oracleproto.Vote{latest, b, next}, votes)
+	assert.Equal(t, 2, duplicates)
+	assert.Equal(t, votes, state.UnsignedVotes())
+}
+
+func TestOracleStateRemoveUnsigned(t *testing.T) {
+	state := NewOracleState(0, 0)
+
+	a := &oracleproto.Vote{OracleId: "a", Timestamp: 10, Data: "1"}
+	b := &oracleproto.Vote{OracleId: "b", Timestamp: 10, Data: "1"}
+	state.AddUnsigned(a, b)
+
+	// votes are removed by key, whatever their data
+	state.RemoveUnsigned(&oracleproto.Vote{OracleId: "a", Timestamp: 10, Data: "2"})
+	assert.Equal(t, []*oracleproto.Vote{b}, state.UnsignedVotes())
+}
+
+func TestOracleStateAddUnsignedPerOracle(t *testing.T) {
+	state := NewOracleState(0, 2)
+
+	votes, _, overflow := state.AddUnsigned(
+		&oracleproto.Vote{OracleId: "a", Timestamp: 10},
+		&oracleproto.Vote{OracleId: "a", Timestamp: 11},
+		&oracleproto.Vote{OracleId: "a", Timestamp: 12},
+		&oracleproto.Vote{OracleId: "b", Timestamp: 10},
+	)
+	assert.Len(t, votes, 3)
+	assert.Equal(t, map[string]int{"a": 1}, overflow)
+
+	// votes already waiting are still replaced by their duplicates
+	latest := &oracleproto.Vote{OracleId: "a", Timestamp: 11, Data: "2"}
+	votes, duplicates, overflow := state.AddUnsigned(latest, &oracleproto.Vote{OracleId: "a", Timestamp: 13})
+	assert.Len(t, votes, 3)
+	assert.Contains(t, votes, latest)
+	assert.Equal(t, 1, duplicates)
+	assert.Equal(t, map[string]int{"a": 1}, overflow)
+}
+
+func TestOracleStatePrune(t *testing.T) {
+	state := NewOracleState(0, 0)
+
+	old := &oracleproto.Vote{OracleId: "a", Timestamp: 5}
+	committed := &oracleproto.Vote{OracleId: "b", Timestamp: 15}
+	fresh := &oracleproto.Vote{OracleId: "c", Timestamp: 15}
+	duplicate := &oracleproto.Vote{OracleId: "c", Timestamp: 15, Data: "dup"}
+	state.AddUnsigned(old, committed, fresh, duplicate)
+
+	valA := ValAddress{0x01}
+	valB := ValAddress{0x02}
+	keptBatch := &oracleproto.GossipedVotes{SignedTimestamp: 15}
+	state.MergeGossip(valA, &oracleproto.GossipedVotes{SignedTimestamp: 5})
+	state.MergeGossip(valB, keptBatch)
+
+	state.Prune(10, func(key string) bool {
+		return key == UnsignedVoteKey(committed)
+	})
+
+	assert.Equal(t, []*oracleproto.Vote{duplicate}, state.UnsignedVotes())
+	assert.Equal(t, []*oracleproto.GossipedVotes{keptBatch}, state.CurrentBatches())
+}
+
+func TestOracleStatePruneConcurrentlyWithRemoveUnsigned(t *testing.T) {
+	state := NewOracleState(0, 0)
+	votes := make([]*oracleproto.Vote, 100)
+	for i := range votes {
+		votes[i] = &oracleproto.Vote{OracleId: "oracle", Timestamp: int64(i)}
+	}
+	state.AddUnsigned(votes...)
+
+	// the votes older than 50 are pruned while every other vote is removed
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		state.Prune(50, func(string) bool { return false })
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < len(votes); i += 2 {
+			state.RemoveUnsigned(votes[i])
+		}
+	}()
+	wg.Wait()
+
+	kept := state.UnsignedVotes()
+	assert.Len(t, kept, 25)
+	for _, vote := range kept {
+		assert.GreaterOrEqual(t, vote.Timestamp, int64(50))
+		assert.Equal(t, int64(1), vote.Timestamp%2)
+	}
+}
+
+func TestOracleStateMaxGossipBytes(t *testing.T) {
+	batch := func(timestamp int64) *oracleproto.GossipedVotes {
+		return &oracleproto.GossipedVotes{
+			SignedTimestamp: timestamp,
+			Votes:           []*oracleproto.Vote{{OracleId: "oracle", Timestamp: timestamp}},
+		}
+	}
+	size := batch(10).Size()
+
+	local := ValAddress{0x01}
+	valA := ValAddress{0x02}
+	valB := ValAddress{0x03}
+	valC := ValAddress{0x04}
+	state := NewOracleState(3*size, 0)
+
+	localBatch := batch(1)
+	state.SealBatch(local, []*oracleproto.GossipedVotes{localBatch})
+	assert.True(t, state.MergeGossip(valA, batch(10)))
+	assert.True(t, state.MergeGossip(valB, batch(20)))
+	assert.Equal(t, 3*size, state.GossipBytes())
+
+	// the oldest batch of another validator is evicted, never the local one
+	assert.True(t, state.MergeGossip(valC, batch(30)))
+	assert.Equal(t, 3*size, state.GossipBytes())
+	assert.Contains(t, state.Batches(), GossipVoteKey{Address: local})
+	assert.NotContains(t, state.Batches(), GossipVoteKey{Address: valA})
+
+	// a batch older than all others is evicted right away
+	assert.False(t, state.MergeGossip(valA, batch(5)))
+	assert.NotContains(t, state.Batches(), GossipVoteKey{Address: valA})
+
+	// the size is tracked as batches are replaced and removed
+	assert.True(t, state.MergeGossip(valB, batch(40)))
+	assert.Equal(t, 3*size, state.GossipBytes())
+	state.RemoveBatches(func(key GossipVoteKey, _ *oracleproto.GossipedVotes) bool { return key.Address == valB })
+	assert.Equal(t, 2*size, state.GossipBytes())
+	state.Prune(35, func(string) bool { return false })
+	assert.Equal(t, 0, state.GossipBytes())
+}
+
+func TestOracleStateRemoveBatches(t *testing.T) {
+	valA := ValAddress{0x01}
+	valB := ValAddress{0x02}
+	state := NewOracleState(0, 0)
+	state.MergeGossip(valA, &oracleproto.GossipedVotes{})
+	state.MergeGossip(valB, &oracleproto.GossipedVotes{})
+
+	removed := state.RemoveBatches(func(key GossipVoteKey, _ *oracleproto.GossipedVotes) bool {
+		return key.Address == valA
+	})
+	assert.Equal(t, 1, removed)
+	assert.Contains(t, state.Batches(), GossipVoteKey{Address: valB})
+}
+
+func TestOracleStateLatestAllowableTimestamp(t *testing.T) {
+	state := NewOracleState(0, 0)
+
+	// not enough blocks yet, bounded by age only
+	state.RecordBlockTimestamp(100, 2)
+	state.RecordBlockTimestamp(100, 2)
+	assert.EqualValues(t, 80, state.LatestAllowableTimestamp(100, 20, 2))
+
+	// the earliest of the last 2 blocks is more recent than the age bound
+	state.RecordBlockTimestamp(110, 2)
+	assert.EqualValues(t, 100, state.LatestAllowableTimestamp(110, 20, 2))
+
+	// the earliest block timestamp is dropped
+	state.RecordBlockTimestamp(115, 2)
+	assert.EqualValues(t, 110, state.LatestAllowableTimestamp(115, 20, 2))
+	assert.EqualValues(t, 180, state.LatestAllowableTimestamp(200, 20, 2))
+}
+
+func TestOracleStateUpdated(t *testing.T) {
+	state := NewOracleState(0, 0)
+
+	updated := state.Updated()
+	assert.Equal(t, updated, state.Updated())
+	select {
+	case <-updated:
+		t.Fatal("expected updated to block before new batches are added")
+	default:
+	}
+
+	state.MergeGossip(ValAddress{0x01}, &oracleproto.GossipedVotes{})
+	select {
+	case <-updated:
+	default:
+		t.Fatal("expected updated to be closed after new batches are added")
+	}
+
+	// a new channel is handed out after a notification
+	assert.NotEqual(t, updated, state.Updated())
+}
@@ -1,9 +1,15 @@
 package utils
 
 import (
+	"bytes"
 	"fmt"
 
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/crypto/sr25519"
 	"github.com/cometbft/cometbft/oracle/service/types"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+	cmttypes "github.com/cometbft/cometbft/types"
 )
 
 // signature prefix for oracle votes is as such:
@@ -27,11 +33,11 @@ func FormSignaturePrefix(isSubAccount bool, signType string) ([]byte, error) {
 	}
 
 	switch signType {
-	case "ed25519":
+	case ed25519.KeyType:
 		sigPrefix = append(sigPrefix, types.Ed25519SignType...)
-	case "sr25519":
+	case sr25519.KeyType:
 		sigPrefix = append(sigPrefix, types.Sr25519SignType...)
-	case "secp256k1":
+	case secp256k1.KeyType:
 		sigPrefix = append(sigPrefix, types.Secp256k1SignType...)
 	default:
 		return nil, fmt.Errorf("FormSignaturePrefix: unsupported sign type: %v", signType)
@@ -47,3 +53,33 @@ func GetSignatureWithoutPrefix(prefixedSig []byte) ([]byte, error) {
 
 	return prefixedSig[2:], nil
 }
+
+// FormGossipedVotesTx encodes a signed batch of votes as a transaction for the
+// mempool fallback transport. The tx is the route followed by the proto
+// encoded batch, letting the app route it to its oracle handler.
+func FormGossipedVotesTx(route string, gossipVote *oracleproto.GossipedVotes) (cmttypes.Tx, error) {
+	if route == "" {
+		return nil, fmt.Errorf("FormGossipedVotesTx: empty route")
+	}
+
+	bz, err := gossipVote.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("FormGossipedVotesTx: unable to marshal gossiped votes: %w", err)
+	}
+
+	return append([]byte(route), bz...), nil
+}
+
+// ParseGossipedVotesTx decodes a transaction formed by FormGossipedVotesTx.
+func ParseGossipedVotesTx(route string, tx cmttypes.Tx) (*oracleproto.GossipedVotes, error) {
+	if route == "" || !bytes.HasPrefix(tx, []byte(route)) {
+		return nil, fmt.Errorf("ParseGossipedVotesTx: tx does not have route prefix: %v", route)
+	}
+
+	gossipVote := &oracleproto.GossipedVotes{}
+	if err := gossipVote.Unmarshal(tx[len(route):]); err != nil {
+		return nil, fmt.Errorf("ParseGossipedVotesTx: unable to unmarshal gossiped votes: %w", err)
+	}
+
+	return gossipVote, nil
+}
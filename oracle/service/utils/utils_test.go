@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/crypto/sr25519"
+	"github.com/cometbft/cometbft/oracle/verify"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+)
+
+func TestGossipedVotesTxRoundTrip(t *testing.T) {
+	gossipVote := &oracleproto.GossipedVotes{
+		PubKey:          []byte{0x01, 0x02},
+		SignedTimestamp: 1700000000,
+		Votes: []*oracleproto.Vote{
+			{Validator: "val", OracleId: "BTC-USD", Timestamp: 1700000000, Data: "42000"},
+		},
+		Signature: []byte{0x00, 0x02, 0x03},
+	}
+
+	tx, err := FormGossipedVotesTx("/oracle", gossipVote)
+	require.NoError(t, err)
+
+	decoded, err := ParseGossipedVotesTx("/oracle", tx)
+	require.NoError(t, err)
+	assert.Equal(t, gossipVote, decoded)
+
+	_, err = ParseGossipedVotesTx("/other", tx)
+	assert.Error(t, err)
+
+	_, err = FormGossipedVotesTx("", gossipVote)
+	assert.Error(t, err)
+}
+
+func TestFormSignaturePrefix(t *testing.T) {
+	for _, tc := range []struct {
+		keyType  string
+		signType byte
+	}{
+		{ed25519.KeyType, verify.Ed25519SignType},
+		{sr25519.KeyType, verify.Sr25519SignType},
+		{secp256k1.KeyType, verify.Secp256k1SignType},
+	} {
+		prefix, err := FormSignaturePrefix(false, tc.keyType)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{verify.MainAccountSigPrefix, tc.signType}, prefix)
+
+		prefix, err = FormSignaturePrefix(true, tc.keyType)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{verify.SubAccountSigPrefix, tc.signType}, prefix)
+	}
+
+	_, err := FormSignaturePrefix(false, "bls12_381")
+	assert.Error(t, err)
+}
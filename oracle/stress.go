@@ -0,0 +1,45 @@
+package oracle
+
+import (
+	"time"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// consensusStress tracks whether consensus is stressed, which is when no new
+// block has been committed for longer than the threshold, e.g. as rounds are
+// timing out. It is no longer stressed as soon as a new block is committed.
+type consensusStress struct {
+	mtx        cmtsync.Mutex
+	threshold  time.Duration
+	height     int64
+	advancedAt time.Time
+	stressed   bool
+}
+
+func newConsensusStress(threshold time.Duration) *consensusStress {
+	return &consensusStress{threshold: threshold}
+}
+
+// Update records the last committed height and returns whether consensus is
+// stressed, and whether that changed since the previous update. A zero
+// threshold never considers consensus stressed.
+func (cs *consensusStress) Update(height int64) (stressed, changed bool) {
+	if cs.threshold == 0 {
+		return false, false
+	}
+
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+
+	now := time.Now()
+	if height != cs.height || cs.advancedAt.IsZero() {
+		cs.height = height
+		cs.advancedAt = now
+	}
+
+	stressed = now.Sub(cs.advancedAt) > cs.threshold
+	changed = stressed != cs.stressed
+	cs.stressed = stressed
+	return stressed, changed
+}
@@ -0,0 +1,38 @@
+package oracle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsensusStress(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		cs := newConsensusStress(0)
+		cs.Update(1)
+		time.Sleep(10 * time.Millisecond)
+		stressed, changed := cs.Update(1)
+		assert.False(t, stressed)
+		assert.False(t, changed)
+	})
+
+	t.Run("stressed until a block is committed", func(t *testing.T) {
+		cs := newConsensusStress(20 * time.Millisecond)
+		stressed, changed := cs.Update(1)
+		assert.False(t, stressed)
+		assert.False(t, changed)
+
+		time.Sleep(30 * time.Millisecond)
+		stressed, changed = cs.Update(1)
+		assert.True(t, stressed)
+		assert.True(t, changed)
+		stressed, changed = cs.Update(1)
+		assert.True(t, stressed)
+		assert.False(t, changed)
+
+		stressed, changed = cs.Update(2)
+		assert.False(t, stressed)
+		assert.True(t, changed)
+	})
+}
@@ -0,0 +1,93 @@
+package oracle
+
+import (
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	oracletypes "github.com/cometbft/cometbft/oracle/service/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// validatorIndex maps validator addresses to their index in the validator
+// set. It is rebuilt whenever the validator set changes so that checking
+// whether the signer of a gossiped vote is a validator does not require a
+// linear scan over the set for every message received.
+type validatorIndex struct {
+	mtx        cmtsync.RWMutex
+	height     int64
+	indices    map[oracletypes.ValAddress]int32
+	powers     []int64
+	totalPower int64
+}
+
+func newValidatorIndex() *validatorIndex {
+	return &validatorIndex{
+		height:  -1,
+		indices: make(map[oracletypes.ValAddress]int32),
+	}
+}
+
+// Height returns the height of the validator set the index was built from.
+func (vi *validatorIndex) Height() int64 {
+	vi.mtx.RLock()
+	defer vi.mtx.RUnlock()
+
+	return vi.height
+}
+
+// Update rebuilds the index from the validators at the given height.
+func (vi *validatorIndex) Update(height int64, validators []*types.Validator) {
+	indices := make(map[oracletypes.ValAddress]int32, len(validators))
+	powers := make([]int64, len(validators))
+	totalPower := int64(0)
+	for idx, val := range validators {
+		indices[oracletypes.ValAddressFromBytes(val.Address)] = int32(idx)
+		powers[idx] = val.VotingPower
+		totalPower += val.VotingPower
+	}
+
+	vi.mtx.Lock()
+	defer vi.mtx.Unlock()
+
+	vi.height = height
+	vi.indices = indices
+	vi.powers = powers
+	vi.totalPower = totalPower
+}
+
+// GetIndex returns the index of the validator with the given address, or -1
+// if the address is not part of the validator set.
+func (vi *validatorIndex) GetIndex(addr oracletypes.ValAddress) int32 {
+	vi.mtx.RLock()
+	defer vi.mtx.RUnlock()
+
+	idx, ok := vi.indices[addr]
+	if !ok {
+		return -1
+	}
+	return idx
+}
+
+// Has returns true if the address is part of the validator set.
+func (vi *validatorIndex) Has(addr oracletypes.ValAddress) bool {
+	return vi.GetIndex(addr) >= 0
+}
+
+// VotingPower returns the voting power of the validator with the given
+// address, or 0 if the address is not part of the validator set.
+func (vi *validatorIndex) VotingPower(addr oracletypes.ValAddress) int64 {
+	vi.mtx.RLock()
+	defer vi.mtx.RUnlock()
+
+	idx, ok := vi.indices[addr]
+	if !ok {
+		return 0
+	}
+	return vi.powers[idx]
+}
+
+// TotalVotingPower returns the total voting power of the validator set.
+func (vi *validatorIndex) TotalVotingPower() int64 {
+	vi.mtx.RLock()
+	defer vi.mtx.RUnlock()
+
+	return vi.totalPower
+}
@@ -0,0 +1,99 @@
+package oracle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	oracletypes "github.com/cometbft/cometbft/oracle/service/types"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+	"github.com/cometbft/cometbft/types"
+)
+
+func makeValidators(n int) []*types.Validator {
+	vals := make([]*types.Validator, n)
+	for i := 0; i < n; i++ {
+		vals[i] = types.NewValidator(ed25519.GenPrivKey().PubKey(), 10)
+	}
+	return vals
+}
+
+func TestValidatorIndex(t *testing.T) {
+	vals := makeValidators(4)
+
+	vi := newValidatorIndex()
+	assert.EqualValues(t, -1, vi.Height())
+	assert.False(t, vi.Has(oracletypes.ValAddressFromBytes(vals[0].Address)))
+
+	vi.Update(5, vals)
+	assert.EqualValues(t, 5, vi.Height())
+	for i, val := range vals {
+		assert.EqualValues(t, i, vi.GetIndex(oracletypes.ValAddressFromBytes(val.Address)))
+		assert.EqualValues(t, 10, vi.VotingPower(oracletypes.ValAddressFromBytes(val.Address)))
+	}
+	assert.EqualValues(t, 40, vi.TotalVotingPower())
+
+	// validator removed from the set on update
+	vi.Update(6, vals[1:])
+	assert.False(t, vi.Has(oracletypes.ValAddressFromBytes(vals[0].Address)))
+	assert.EqualValues(t, 0, vi.VotingPower(oracletypes.ValAddressFromBytes(vals[0].Address)))
+	assert.EqualValues(t, 30, vi.TotalVotingPower())
+	assert.EqualValues(t, 0, vi.GetIndex(oracletypes.ValAddressFromBytes(vals[1].Address)))
+}
+
+func TestValAddressString(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+	assert.Equal(t, pubKey.Address().String(), oracletypes.ValAddressFromPubKey(pubKey).String())
+}
+
+func BenchmarkValidatorIndexLookup(b *testing.B) {
+	vals := makeValidators(150)
+	vi := newValidatorIndex()
+	vi.Update(1, vals)
+	addr := oracletypes.ValAddressFromBytes(vals[len(vals)-1].Address)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		vi.Has(addr)
+	}
+}
+
+func BenchmarkValidatorSetHasAddress(b *testing.B) {
+	vals := makeValidators(150)
+	valSet := types.NewValidatorSet(vals)
+	addr := vals[len(vals)-1].Address
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		valSet.HasAddress(addr)
+	}
+}
+
+func BenchmarkGossipBufferLookupValAddress(b *testing.B) {
+	vals := makeValidators(150)
+	buffer := make(map[oracletypes.ValAddress]*oracleproto.GossipedVotes, len(vals))
+	for _, val := range vals {
+		buffer[oracletypes.ValAddressFromBytes(val.Address)] = &oracleproto.GossipedVotes{}
+	}
+	pubKey := vals[len(vals)-1].PubKey
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = buffer[oracletypes.ValAddressFromPubKey(pubKey)]
+	}
+}
+
+func BenchmarkGossipBufferLookupString(b *testing.B) {
+	vals := makeValidators(150)
+	buffer := make(map[string]*oracleproto.GossipedVotes, len(vals))
+	for _, val := range vals {
+		buffer[val.Address.String()] = &oracleproto.GossipedVotes{}
+	}
+	pubKey := vals[len(vals)-1].PubKey
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = buffer[pubKey.Address().String()]
+	}
+}
@@ -0,0 +1,81 @@
+package oracle
+
+import (
+	"container/list"
+	"crypto/sha256"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+)
+
+// verifiedBatchesCacheSize is the number of verified batches remembered, a
+// few times the number of batches gossiped by a large validator set
+const verifiedBatchesCacheSize = 10000
+
+// batchHash identifies the signed content of a batch of votes.
+type batchHash [sha256.Size]byte
+
+// hashBatch returns the hash of the batch, leaving out its unsigned metadata
+// so that the hash is the same whichever peer relayed the batch.
+func hashBatch(batch *oracleproto.GossipedVotes) (batchHash, error) {
+	b := *batch
+	b.RelayHops = nil
+	b.SignerMetadata = nil
+	bz, err := b.Marshal()
+	if err != nil {
+		return batchHash{}, err
+	}
+	return sha256.Sum256(bz), nil
+}
+
+// verifiedBatches is a thread-safe LRU cache of the hashes of the batches of
+// votes whose signature was verified, so that a batch received from several
+// peers only has its sign bytes computed and its signature verified once.
+type verifiedBatches struct {
+	mtx      cmtsync.Mutex
+	size     int
+	cacheMap map[batchHash]*list.Element
+	list     *list.List
+}
+
+func newVerifiedBatches(size int) *verifiedBatches {
+	return &verifiedBatches{
+		size:     size,
+		cacheMap: make(map[batchHash]*list.Element, size),
+		list:     list.New(),
+	}
+}
+
+// Has returns true if the batch with the given hash was verified, marking it
+// as recently used.
+func (c *verifiedBatches) Has(hash batchHash) bool {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	e, ok := c.cacheMap[hash]
+	if ok {
+		c.list.MoveToBack(e)
+	}
+	return ok
+}
+
+// Add records that the batch with the given hash was verified, evicting the
+// least recently used hash if the cache is full.
+func (c *verifiedBatches) Add(hash batchHash) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if e, ok := c.cacheMap[hash]; ok {
+		c.list.MoveToBack(e)
+		return
+	}
+
+	if c.list.Len() >= c.size {
+		if front := c.list.Front(); front != nil {
+			delete(c.cacheMap, front.Value.(batchHash))
+			c.list.Remove(front)
+		}
+	}
+
+	c.cacheMap[hash] = c.list.PushBack(hash)
+}
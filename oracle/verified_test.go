@@ -0,0 +1,59 @@
+package oracle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+)
+
+func TestHashBatch(t *testing.T) {
+	batch := &oracleproto.GossipedVotes{
+		PubKey:          []byte{0x01},
+		Votes:           []*oracleproto.Vote{{OracleId: "a", Timestamp: 10, Data: "1"}},
+		SignedTimestamp: 10,
+		Signature:       []byte{0x02},
+	}
+	hash, err := hashBatch(batch)
+	require.NoError(t, err)
+
+	// unsigned metadata does not change the hash
+	relayed := *batch
+	relayed.RelayHops = []*oracleproto.RelayHop{{NodeId: "node", ReceivedAt: 1}}
+	relayed.SignerMetadata = &oracleproto.SignerMetadata{Moniker: "validator"}
+	relayedHash, err := hashBatch(&relayed)
+	require.NoError(t, err)
+	assert.Equal(t, hash, relayedHash)
+	assert.Len(t, relayed.RelayHops, 1)
+
+	// anything else does
+	tampered := *batch
+	tampered.Votes = []*oracleproto.Vote{{OracleId: "a", Timestamp: 10, Data: "2"}}
+	tamperedHash, err := hashBatch(&tampered)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, tamperedHash)
+
+	resigned := *batch
+	resigned.Signature = []byte{0x03}
+	resignedHash, err := hashBatch(&resigned)
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, resignedHash)
+}
+
+func TestVerifiedBatches(t *testing.T) {
+	cache := newVerifiedBatches(2)
+	a, b, c := batchHash{0x01}, batchHash{0x02}, batchHash{0x03}
+
+	assert.False(t, cache.Has(a))
+	cache.Add(a)
+	cache.Add(b)
+	assert.True(t, cache.Has(a))
+
+	// b is the least recently used
+	cache.Add(c)
+	assert.True(t, cache.Has(a))
+	assert.False(t, cache.Has(b))
+	assert.True(t, cache.Has(c))
+}
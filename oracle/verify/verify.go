@@ -0,0 +1,204 @@
+// Package verify verifies signed batches of oracle votes. It only depends on
+// the crypto and oracle proto packages, so that relayers, auditors and bridges
+// can check the batches gossiped by validators, or submitted in oracle result
+// txs, without importing the node.
+package verify
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/crypto/sr25519"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	"github.com/cometbft/cometbft/libs/protoio"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+)
+
+// Batch signatures are prefixed by the type of account that signed the batch,
+// followed by the type of its key.
+const (
+	MainAccountSigPrefix byte = 0x00
+	SubAccountSigPrefix  byte = 0x01
+
+	Ed25519SignType   byte = 0x02
+	Sr25519SignType   byte = 0x03
+	Secp256k1SignType byte = 0x04
+
+	// SignaturePrefixSize is the size of the account and sign type prefix.
+	SignaturePrefixSize = 2
+)
+
+// GossipedVotes verifies that the batch is signed by its public key for the
+// given chain, and that the data of votes gossiped by data hash matches the
+// hash. It returns the public key that signed the batch.
+//
+// It does not check that the signer is a validator, or a sub account of one,
+// which requires the validator set and the application.
+func GossipedVotes(chainID string, batch *oracleproto.GossipedVotes) (crypto.PubKey, error) {
+	pubKey, err := PubKey(batch)
+	if err != nil {
+		return nil, err
+	}
+	for _, vote := range batch.Votes {
+		if err := ValidateVoteData(vote); err != nil {
+			return nil, fmt.Errorf("vote %q at %d: %w", vote.OracleId, vote.Timestamp, err)
+		}
+	}
+	if err := Signature(chainID, batch, pubKey); err != nil {
+		return nil, err
+	}
+	return pubKey, nil
+}
+
+// PubKey returns the public key of the batch, of the key type given by the
+// signature prefix.
+func PubKey(batch *oracleproto.GossipedVotes) (crypto.PubKey, error) {
+	if len(batch.Signature) < SignaturePrefixSize {
+		return nil, errors.New("signature is too short")
+	}
+	switch batch.Signature[1] {
+	case Ed25519SignType:
+		if len(batch.PubKey) != ed25519.PubKeySize {
+			return nil, errors.New("invalid ed25519 public key size")
+		}
+		return ed25519.PubKey(batch.PubKey), nil
+	case Sr25519SignType:
+		if len(batch.PubKey) != sr25519.PubKeySize {
+			return nil, errors.New("invalid sr25519 public key size")
+		}
+		return sr25519.PubKey(batch.PubKey), nil
+	case Secp256k1SignType:
+		if len(batch.PubKey) != secp256k1.PubKeySize {
+			return nil, errors.New("invalid secp256k1 public key size")
+		}
+		return secp256k1.PubKey(batch.PubKey), nil
+	default:
+		return nil, fmt.Errorf("unsupported sign type %#x", batch.Signature[1])
+	}
+}
+
+// IsSubAccount returns whether the batch was signed by a sub account, rather
+// than by the main account of a validator.
+func IsSubAccount(batch *oracleproto.GossipedVotes) (bool, error) {
+	if len(batch.Signature) < SignaturePrefixSize {
+		return false, errors.New("signature is too short")
+	}
+	switch batch.Signature[0] {
+	case MainAccountSigPrefix:
+		return false, nil
+	case SubAccountSigPrefix:
+		return true, nil
+	default:
+		return false, fmt.Errorf("unsupported account type %#x", batch.Signature[0])
+	}
+}
+
+// Signature verifies the batch signature for the given chain and public key.
+func Signature(chainID string, batch *oracleproto.GossipedVotes, pubKey crypto.PubKey) error {
+	if len(batch.Signature) < SignaturePrefixSize {
+		return errors.New("signature is too short")
+	}
+	if !pubKey.VerifySignature(SignBytes(chainID, batch), batch.Signature[SignaturePrefixSize:]) {
+		return errors.New("invalid batch signature")
+	}
+	return nil
+}
+
+// SignBytes returns the bytes signed by the batch for the given chain.
+func SignBytes(chainID string, batch *oracleproto.GossipedVotes) []byte {
+	return CanonicalSignBytes(&oracleproto.CanonicalGossipedVotes{
+		PubKey:          batch.PubKey,
+		SignedTimestamp: batch.SignedTimestamp,
+		ChainId:         chainID,
+		BatchSeq:        batch.BatchSeq,
+		VotesHash:       VotesHash(batch.Votes),
+	})
+}
+
+// LegacySignature verifies that the batch is signed by the public key for the
+// given chain in the legacy form, over all of its votes rather than over their
+// merkle root, as by nodes predating the signing over the merkle root. Such
+// batches can't be proven against, nor included in oracle result txs.
+func LegacySignature(chainID string, batch *oracleproto.GossipedVotes, pubKey crypto.PubKey) error {
+	if len(batch.Signature) < SignaturePrefixSize {
+		return errors.New("signature is too short")
+	}
+	bz, err := protoio.MarshalDelimited(&oracleproto.LegacyCanonicalGossipedVotes{
+		PubKey:          batch.PubKey,
+		Votes:           batch.Votes,
+		SignedTimestamp: batch.SignedTimestamp,
+		ChainId:         chainID,
+	})
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(bz, batch.Signature[SignaturePrefixSize:]) {
+		return errors.New("invalid legacy batch signature")
+	}
+	return nil
+}
+
+// CanonicalSignBytes returns the length delimited encoding of the canonical
+// batch, which is what is signed.
+func CanonicalSignBytes(pb *oracleproto.CanonicalGossipedVotes) []byte {
+	bz, err := protoio.MarshalDelimited(pb)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// VotesHash returns the merkle root of the votes in a batch. The leaves of
+// the tree are the proto encoded votes, in the order they appear in the
+// batch.
+func VotesHash(votes []*oracleproto.Vote) []byte {
+	return merkle.HashFromByteSlices(VoteLeaves(votes))
+}
+
+// VoteLeaves returns the merkle leaves of the votes.
+func VoteLeaves(votes []*oracleproto.Vote) [][]byte {
+	leaves := make([][]byte, len(votes))
+	for i, vote := range votes {
+		leaves[i] = VoteLeaf(vote)
+	}
+	return leaves
+}
+
+// VoteLeaf returns the leaf committing to the vote. Votes gossiped by data
+// hash commit to the hash only, so that the data can be filled in once
+// fetched without invalidating the signature.
+func VoteLeaf(vote *oracleproto.Vote) []byte {
+	if len(vote.DataHash) > 0 && vote.Data != "" {
+		v := *vote
+		v.Data = ""
+		vote = &v
+	}
+	bz, err := vote.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// DataHash returns the hash of the vote data, used in place of the data for
+// votes whose data is too large to be gossiped inline.
+func DataHash(data string) []byte {
+	return tmhash.Sum([]byte(data))
+}
+
+// ValidateVoteData returns an error if the vote is gossiped by data hash and
+// carries data not matching the hash.
+func ValidateVoteData(vote *oracleproto.Vote) error {
+	if len(vote.DataHash) == 0 || vote.Data == "" {
+		return nil
+	}
+	if !bytes.Equal(DataHash(vote.Data), vote.DataHash) {
+		return errors.New("vote data does not match data hash")
+	}
+	return nil
+}
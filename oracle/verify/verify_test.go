@@ -0,0 +1,134 @@
+package verify
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	"github.com/cometbft/cometbft/libs/protoio"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+)
+
+const chainID = "verify-chain"
+
+func signBatch(t *testing.T, privKey crypto.PrivKey, prefix []byte, batch *oracleproto.GossipedVotes) {
+	batch.PubKey = privKey.PubKey().Bytes()
+	sig, err := privKey.Sign(SignBytes(chainID, batch))
+	require.NoError(t, err)
+	batch.Signature = append(prefix, sig...)
+}
+
+func makeBatch() *oracleproto.GossipedVotes {
+	return &oracleproto.GossipedVotes{
+		SignedTimestamp: 10,
+		BatchSeq:        1,
+		Votes: []*oracleproto.Vote{
+			{OracleId: "a", Timestamp: 10, Data: "1"},
+			{OracleId: "b", Timestamp: 10, Data: "2", DataHash: DataHash("2")},
+		},
+	}
+}
+
+func TestGossipedVotes(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		privKey  crypto.PrivKey
+		signType byte
+	}{
+		{"ed25519", ed25519.GenPrivKey(), Ed25519SignType},
+		{"secp256k1", secp256k1.GenPrivKey(), Secp256k1SignType},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			batch := makeBatch()
+			signBatch(t, tc.privKey, []byte{MainAccountSigPrefix, tc.signType}, batch)
+
+			pubKey, err := GossipedVotes(chainID, batch)
+			require.NoError(t, err)
+			assert.Equal(t, tc.privKey.PubKey(), pubKey)
+
+			_, err = GossipedVotes("other-chain", batch)
+			assert.Error(t, err)
+
+			batch.Votes[0].Data = "3"
+			_, err = GossipedVotes(chainID, batch)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLegacySignature(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	batch := &oracleproto.GossipedVotes{
+		PubKey:          privKey.PubKey().Bytes(),
+		SignedTimestamp: 10,
+		Votes:           []*oracleproto.Vote{{OracleId: "a", Timestamp: 10, Data: "1"}},
+	}
+	// signed over all of its votes, as by nodes predating the merkle root
+	bz, err := protoio.MarshalDelimited(&oracleproto.LegacyCanonicalGossipedVotes{
+		PubKey:          batch.PubKey,
+		Votes:           batch.Votes,
+		SignedTimestamp: batch.SignedTimestamp,
+		ChainId:         chainID,
+	})
+	require.NoError(t, err)
+	sig, err := privKey.Sign(bz)
+	require.NoError(t, err)
+	batch.Signature = append([]byte{MainAccountSigPrefix, Ed25519SignType}, sig...)
+
+	assert.NoError(t, LegacySignature(chainID, batch, privKey.PubKey()))
+	assert.Error(t, Signature(chainID, batch, privKey.PubKey()))
+
+	batch.Votes[0].Data = "2"
+	assert.Error(t, LegacySignature(chainID, batch, privKey.PubKey()))
+
+	// batches signed over the merkle root are not legacy batches
+	batch = makeBatch()
+	signBatch(t, privKey, []byte{MainAccountSigPrefix, Ed25519SignType}, batch)
+	assert.Error(t, LegacySignature(chainID, batch, privKey.PubKey()))
+}
+
+func TestGossipedVotesDataHash(t *testing.T) {
+	batch := makeBatch()
+	signBatch(t, ed25519.GenPrivKey(), []byte{MainAccountSigPrefix, Ed25519SignType}, batch)
+
+	// data filled in after signing does not change the signed bytes
+	batch.Votes[1].Data = ""
+	_, err := GossipedVotes(chainID, batch)
+	require.NoError(t, err)
+
+	batch.Votes[1].Data = "3"
+	_, err = GossipedVotes(chainID, batch)
+	assert.Error(t, err)
+}
+
+func TestPubKey(t *testing.T) {
+	privKey := ed25519.GenPrivKey()
+	batch := makeBatch()
+
+	signBatch(t, privKey, []byte{SubAccountSigPrefix, Ed25519SignType}, batch)
+	pubKey, err := PubKey(batch)
+	require.NoError(t, err)
+	assert.Equal(t, privKey.PubKey(), pubKey)
+	isSubAccount, err := IsSubAccount(batch)
+	require.NoError(t, err)
+	assert.True(t, isSubAccount)
+
+	// the key does not match the sign type
+	batch.Signature[1] = Secp256k1SignType
+	_, err = PubKey(batch)
+	assert.Error(t, err)
+
+	batch.Signature[0], batch.Signature[1] = 0x05, 0x05
+	_, err = PubKey(batch)
+	assert.Error(t, err)
+	_, err = IsSubAccount(batch)
+	assert.Error(t, err)
+
+	batch.Signature = []byte{MainAccountSigPrefix}
+	_, err = PubKey(batch)
+	assert.Error(t, err)
+}
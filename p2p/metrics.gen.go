@@ -32,6 +32,12 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "peer_send_bytes_total",
 			Help:      "Number of bytes sent to a given peer.",
 		}, append(labels, "peer_id", "chID")).With(labelsAndValues...),
+		PeerSendFailuresTotal: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "peer_send_failures_total",
+			Help:      "Number of messages that could not be sent to a given peer, by reason: queue_full when the channel's send queue stayed full, stopped when the peer was stopped, unknown_channel when the peer does not have the channel, and marshal when the message could not be encoded.",
+		}, append(labels, "peer_id", "chID", "reason")).With(labelsAndValues...),
 		PeerPendingSendBytes: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
 			Namespace: namespace,
 			Subsystem: MetricsSubsystem,
@@ -64,6 +70,7 @@ func NopMetrics() *Metrics {
 		Peers:                    discard.NewGauge(),
 		PeerReceiveBytesTotal:    discard.NewCounter(),
 		PeerSendBytesTotal:       discard.NewCounter(),
+		PeerSendFailuresTotal:    discard.NewCounter(),
 		PeerPendingSendBytes:     discard.NewGauge(),
 		NumTxs:                   discard.NewGauge(),
 		MessageReceiveBytesTotal: discard.NewCounter(),
@@ -32,6 +32,11 @@ type Metrics struct {
 	PeerReceiveBytesTotal metrics.Counter `metrics_labels:"peer_id,chID"`
 	// Number of bytes sent to a given peer.
 	PeerSendBytesTotal metrics.Counter `metrics_labels:"peer_id,chID"`
+	// Number of messages that could not be sent to a given peer, by reason:
+	// queue_full when the channel's send queue stayed full, stopped when the
+	// peer was stopped, unknown_channel when the peer does not have the
+	// channel, and marshal when the message could not be encoded.
+	PeerSendFailuresTotal metrics.Counter `metrics_labels:"peer_id,chID,reason"`
 	// Pending bytes to be sent to a given peer.
 	PeerPendingSendBytes metrics.Gauge `metrics_labels:"peer_id"`
 	// Number of transactions submitted by each peer.
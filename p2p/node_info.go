@@ -97,8 +97,9 @@ type DefaultNodeInfo struct {
 
 // DefaultNodeInfoOther is the misc. applcation specific data
 type DefaultNodeInfoOther struct {
-	TxIndex    string `json:"tx_index"`
-	RPCAddress string `json:"rpc_address"`
+	TxIndex       string `json:"tx_index"`
+	RPCAddress    string `json:"rpc_address"`
+	OracleVersion string `json:"oracle_version"`
 }
 
 // ID returns the node's peer ID.
@@ -169,6 +170,10 @@ func (info DefaultNodeInfo) Validate() error {
 	if len(rpcAddr) > 0 && (!cmtstrings.IsASCIIText(rpcAddr) || cmtstrings.ASCIITrim(rpcAddr) == "") {
 		return fmt.Errorf("info.Other.RPCAddress=%v must be valid ASCII text without tabs", rpcAddr)
 	}
+	oracleVersion := other.OracleVersion
+	if len(oracleVersion) > 0 && (!cmtstrings.IsASCIIText(oracleVersion) || cmtstrings.ASCIITrim(oracleVersion) == "") {
+		return fmt.Errorf("info.Other.OracleVersion=%v must be valid ASCII text without tabs", oracleVersion)
+	}
 
 	return nil
 }
@@ -243,8 +248,9 @@ func (info DefaultNodeInfo) ToProto() *tmp2p.DefaultNodeInfo {
 	dni.Channels = info.Channels
 	dni.Moniker = info.Moniker
 	dni.Other = tmp2p.DefaultNodeInfoOther{
-		TxIndex:    info.Other.TxIndex,
-		RPCAddress: info.Other.RPCAddress,
+		TxIndex:       info.Other.TxIndex,
+		RPCAddress:    info.Other.RPCAddress,
+		OracleVersion: info.Other.OracleVersion,
 	}
 
 	return dni
@@ -267,8 +273,9 @@ func DefaultNodeInfoFromToProto(pb *tmp2p.DefaultNodeInfo) (DefaultNodeInfo, err
 		Channels:      pb.Channels,
 		Moniker:       pb.Moniker,
 		Other: DefaultNodeInfoOther{
-			TxIndex:    pb.Other.TxIndex,
-			RPCAddress: pb.Other.RPCAddress,
+			TxIndex:       pb.Other.TxIndex,
+			RPCAddress:    pb.Other.RPCAddress,
+			OracleVersion: pb.Other.OracleVersion,
 		},
 	}
 
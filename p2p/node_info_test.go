@@ -64,6 +64,11 @@ func TestNodeInfoValidate(t *testing.T) {
 		{"Empty space RPCAddress", func(ni *DefaultNodeInfo) { ni.Other.RPCAddress = emptySpace }, true},
 		{"Empty RPCAddress", func(ni *DefaultNodeInfo) { ni.Other.RPCAddress = "" }, false},
 		{"Good RPCAddress", func(ni *DefaultNodeInfo) { ni.Other.RPCAddress = "0.0.0.0:26657" }, false},
+
+		{"Non-ASCII OracleVersion", func(ni *DefaultNodeInfo) { ni.Other.OracleVersion = nonASCII }, true},
+		{"Empty tab OracleVersion", func(ni *DefaultNodeInfo) { ni.Other.OracleVersion = emptyTab }, true},
+		{"Empty OracleVersion", func(ni *DefaultNodeInfo) { ni.Other.OracleVersion = "" }, false},
+		{"Good OracleVersion", func(ni *DefaultNodeInfo) { ni.Other.OracleVersion = "2" }, false},
 	}
 
 	nodeKey := NodeKey{PrivKey: ed25519.GenPrivKey()}
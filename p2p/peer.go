@@ -269,8 +269,10 @@ func (p *peer) TrySend(e Envelope) bool {
 
 func (p *peer) send(chID byte, msg proto.Message, sendFunc func(byte, []byte) bool) bool {
 	if !p.IsRunning() {
+		p.sendFailed(chID, "stopped")
 		return false
 	} else if !p.hasChannel(chID) {
+		p.sendFailed(chID, "unknown_channel")
 		return false
 	}
 	metricLabelValue := p.mlc.ValueToMetricLabel(msg)
@@ -280,6 +282,7 @@ func (p *peer) send(chID byte, msg proto.Message, sendFunc func(byte, []byte) bo
 	msgBytes, err := proto.Marshal(msg)
 	if err != nil {
 		p.Logger.Error("marshaling message to send", "error", err)
+		p.sendFailed(chID, "marshal")
 		return false
 	}
 	res := sendFunc(chID, msgBytes)
@@ -290,10 +293,23 @@ func (p *peer) send(chID byte, msg proto.Message, sendFunc func(byte, []byte) bo
 		}
 		p.metrics.PeerSendBytesTotal.With(labels...).Add(float64(len(msgBytes)))
 		p.metrics.MessageSendBytesTotal.With("message_type", metricLabelValue).Add(float64(len(msgBytes)))
+	} else if p.mconn.IsRunning() {
+		p.sendFailed(chID, "queue_full")
+	} else {
+		p.sendFailed(chID, "stopped")
 	}
 	return res
 }
 
+// sendFailed counts a message that could not be sent on the given channel.
+func (p *peer) sendFailed(chID byte, reason string) {
+	p.metrics.PeerSendFailuresTotal.With(
+		"peer_id", string(p.ID()),
+		"chID", fmt.Sprintf("%#x", chID),
+		"reason", reason,
+	).Add(1)
+}
+
 // Get the data for a given key.
 func (p *peer) Get(key string) interface{} {
 	return p.Data.Get(key)
@@ -405,6 +421,11 @@ func createMConnection(
 			panic(fmt.Sprintf("Unknown channel %X", chID))
 		}
 		mt := msgTypeByChID[chID]
+		if v, ok := mt.(EncodingValidator); ok {
+			if err := v.ValidateEncoding(msgBytes); err != nil {
+				panic(fmt.Errorf("invalid message encoding for type %s: %w", reflect.TypeOf(mt), err))
+			}
+		}
 		msg := proto.Clone(mt)
 		err := proto.Unmarshal(msgBytes, msg)
 		if err != nil {
@@ -4,10 +4,12 @@ import (
 	"fmt"
 	golog "log"
 	"net"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/cosmos/gogoproto/proto"
+	"github.com/go-kit/kit/metrics"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -75,6 +77,45 @@ func TestPeerSend(t *testing.T) {
 	assert.True(p.Send(Envelope{ChannelID: testCh, Message: &p2p.Message{}}))
 }
 
+func TestPeerSendFailureMetrics(t *testing.T) {
+	require := require.New(t)
+
+	// simulate remote peer
+	rp := &remotePeer{PrivKey: ed25519.GenPrivKey(), Config: cfg}
+	rp.Start()
+	t.Cleanup(rp.Stop)
+
+	p, err := createOutboundPeerAndPerformHandshake(rp.Addr(), cfg, cmtconn.DefaultMConnConfig())
+	require.Nil(err)
+	failures := &labelCounter{values: make(map[string]float64)}
+	p.metrics.PeerSendFailuresTotal = failures
+
+	require.Nil(p.Start())
+	assert.False(t, p.Send(Envelope{ChannelID: 0x99, Message: &p2p.Message{}}))
+	require.Nil(p.Stop())
+	assert.False(t, p.Send(Envelope{ChannelID: testCh, Message: &p2p.Message{}}))
+
+	assert.Equal(t, map[string]float64{
+		fmt.Sprintf("peer_id,%s,chID,0x99,reason,unknown_channel", p.ID()): 1,
+		fmt.Sprintf("peer_id,%s,chID,%#x,reason,stopped", p.ID(), testCh):  1,
+	}, failures.values)
+}
+
+// labelCounter is a metrics.Counter recording the total added for each set
+// of label values.
+type labelCounter struct {
+	labels []string
+	values map[string]float64
+}
+
+func (c *labelCounter) With(labelValues ...string) metrics.Counter {
+	return &labelCounter{labels: append(c.labels, labelValues...), values: c.values}
+}
+
+func (c *labelCounter) Add(delta float64) {
+	c.values[strings.Join(c.labels, ",")] += delta
+}
+
 func createOutboundPeerAndPerformHandshake(
 	addr *NetAddress,
 	config *config.P2PConfig,
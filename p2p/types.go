@@ -35,6 +35,18 @@ type Wrapper interface {
 	Wrap() proto.Message
 }
 
+// EncodingValidator is a Protobuf message that checks the encoding of inbound
+// messages before they are unmarshaled. If a Channel's message type implements
+// EncodingValidator, the p2p layer rejects inbound messages for which
+// ValidateEncoding returns an error, e.g. to bound the allocations made while
+// unmarshaling messages crafted by peers.
+type EncodingValidator interface {
+	proto.Message
+
+	// ValidateEncoding checks the encoded message, without unmarshaling it.
+	ValidateEncoding(bz []byte) error
+}
+
 var (
 	_ Wrapper = &tmp2p.PexRequest{}
 	_ Wrapper = &tmp2p.PexAddrs{}
@@ -0,0 +1,46 @@
+// Code generated by metricsgen. DO NOT EDIT.
+
+package privval
+
+import (
+	"github.com/go-kit/kit/metrics/discard"
+	prometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
+	labels := []string{}
+	for i := 0; i < len(labelsAndValues); i += 2 {
+		labels = append(labels, labelsAndValues[i])
+	}
+	return &Metrics{
+		OracleVoteSignSeconds: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "oracle_vote_sign_seconds",
+			Help:      "Histogram of the time taken to sign a batch of oracle votes.",
+
+			Buckets: stdprometheus.ExponentialBucketsRange(0.0001, 10, 10),
+		}, labels).With(labelsAndValues...),
+		OracleVoteSignErrors: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "oracle_vote_sign_errors",
+			Help:      "Number of failed oracle vote signing requests.",
+		}, labels).With(labelsAndValues...),
+		OracleVoteSignTimeouts: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "oracle_vote_sign_timeouts",
+			Help:      "Number of oracle vote signing requests that timed out waiting for the remote signer.",
+		}, labels).With(labelsAndValues...),
+	}
+}
+
+func NopMetrics() *Metrics {
+	return &Metrics{
+		OracleVoteSignSeconds:  discard.NewHistogram(),
+		OracleVoteSignErrors:   discard.NewCounter(),
+		OracleVoteSignTimeouts: discard.NewCounter(),
+	}
+}
@@ -0,0 +1,26 @@
+package privval
+
+import (
+	"github.com/go-kit/kit/metrics"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "privval"
+)
+
+//go:generate go run ../scripts/metricsgen -struct=Metrics
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Histogram of the time taken to sign a batch of oracle votes.
+	OracleVoteSignSeconds metrics.Histogram `metrics_buckettype:"exprange" metrics_bucketsizes:"0.0001, 10, 10"`
+
+	// Number of failed oracle vote signing requests.
+	OracleVoteSignErrors metrics.Counter
+
+	// Number of oracle vote signing requests that timed out waiting for the
+	// remote signer.
+	OracleVoteSignTimeouts metrics.Counter
+}
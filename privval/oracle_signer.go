@@ -0,0 +1,54 @@
+package privval
+
+import (
+	"errors"
+	"net"
+	"time"
+
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+	"github.com/cometbft/cometbft/types"
+)
+
+// OracleMetricsSigner wraps a PrivValidator and records metrics on the
+// signing of oracle votes, so slow signers can be told apart from slow data
+// sources.
+type OracleMetricsSigner struct {
+	types.PrivValidator
+	metrics *Metrics
+}
+
+var _ types.PrivValidator = (*OracleMetricsSigner)(nil)
+
+// NewOracleMetricsSigner returns a PrivValidator recording oracle vote
+// signing metrics, and otherwise delegating to pv.
+func NewOracleMetricsSigner(pv types.PrivValidator, metrics *Metrics) *OracleMetricsSigner {
+	return &OracleMetricsSigner{
+		PrivValidator: pv,
+		metrics:       metrics,
+	}
+}
+
+// SignOracleVote implements PrivValidator.
+func (s *OracleMetricsSigner) SignOracleVote(chainID string, vote *oracleproto.GossipedVotes, sigPrefix []byte) error {
+	start := time.Now()
+	err := s.PrivValidator.SignOracleVote(chainID, vote, sigPrefix)
+	s.metrics.OracleVoteSignSeconds.Observe(time.Since(start).Seconds())
+
+	if err != nil {
+		s.metrics.OracleVoteSignErrors.Add(1)
+		if isTimeout(err) {
+			s.metrics.OracleVoteSignTimeouts.Add(1)
+		}
+	}
+	return err
+}
+
+// isTimeout returns true if the error is caused by a remote signer endpoint
+// timing out.
+func isTimeout(err error) bool {
+	if errors.Is(err, ErrReadTimeout) || errors.Is(err, ErrWriteTimeout) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,47 @@
+package privval
+
+import (
+	"testing"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/stretchr/testify/assert"
+
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestOracleMetricsSigner(t *testing.T) {
+	errs, timeouts := &testCounter{}, &testCounter{}
+	metrics := &Metrics{
+		OracleVoteSignSeconds:  discard.NewHistogram(),
+		OracleVoteSignErrors:   errs,
+		OracleVoteSignTimeouts: timeouts,
+	}
+
+	signer := NewOracleMetricsSigner(types.NewMockPV(), metrics)
+	assert.NoError(t, signer.SignOracleVote("chain", &oracleproto.GossipedVotes{}, []byte{0, 0}))
+	assert.EqualValues(t, 0, errs.value)
+
+	signer = NewOracleMetricsSigner(&timeoutPV{MockPV: types.NewMockPV()}, metrics)
+	assert.Error(t, signer.SignOracleVote("chain", &oracleproto.GossipedVotes{}, []byte{0, 0}))
+	assert.EqualValues(t, 1, errs.value)
+	assert.EqualValues(t, 1, timeouts.value)
+}
+
+// timeoutPV is a PrivValidator whose oracle vote signing times out.
+type timeoutPV struct {
+	types.MockPV
+}
+
+func (pv *timeoutPV) SignOracleVote(string, *oracleproto.GossipedVotes, []byte) error {
+	return ErrConnectionTimeout
+}
+
+// testCounter is a metrics.Counter recording the total added to it.
+type testCounter struct {
+	value float64
+}
+
+func (c *testCounter) With(...string) metrics.Counter { return c }
+func (c *testCounter) Add(delta float64)              { c.value += delta }
@@ -0,0 +1,92 @@
+package oracle
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	// MaxVotesPerGossipedVotes bounds the votes of a received batch of votes.
+	MaxVotesPerGossipedVotes = 10000
+	// MaxRelayHopsPerGossipedVotes bounds the relay hops of a received batch
+	// of votes.
+	MaxRelayHopsPerGossipedVotes = 64
+	// MaxDataHashesPerRequest bounds the data hashes of a received data
+	// request.
+	MaxDataHashesPerRequest = 10000
+)
+
+// ValidateEncoding implements the p2p EncodingValidator interface and checks
+// that an encoded batch of votes does not have more votes or relay hops than
+// allowed, before it is unmarshaled.
+func (m *GossipedVotes) ValidateEncoding(bz []byte) error {
+	votes, relayHops := 0, 0
+	err := rangeFields(bz, func(num protowire.Number, _ []byte) error {
+		switch num {
+		case 2:
+			votes++
+			if votes > MaxVotesPerGossipedVotes {
+				return fmt.Errorf("more than %d votes", MaxVotesPerGossipedVotes)
+			}
+		case 6:
+			relayHops++
+			if relayHops > MaxRelayHopsPerGossipedVotes {
+				return fmt.Errorf("more than %d relay hops", MaxRelayHopsPerGossipedVotes)
+			}
+		}
+		return nil
+	})
+	return err
+}
+
+// ValidateEncoding implements the p2p EncodingValidator interface and checks
+// that an encoded data request does not have more data hashes than allowed,
+// before it is unmarshaled.
+func (m *Message) ValidateEncoding(bz []byte) error {
+	dataHashes := 0
+	return rangeFields(bz, func(num protowire.Number, value []byte) error {
+		if num != 1 {
+			return nil
+		}
+		return rangeFields(value, func(num protowire.Number, _ []byte) error {
+			if num != 1 {
+				return nil
+			}
+			dataHashes++
+			if dataHashes > MaxDataHashesPerRequest {
+				return fmt.Errorf("more than %d data hashes", MaxDataHashesPerRequest)
+			}
+			return nil
+		})
+	})
+}
+
+// rangeFields calls fn for each field of the encoded message, along with the
+// value of length-delimited fields, without allocating. It stops at the first
+// error returned by fn or encountered decoding the message.
+func rangeFields(bz []byte, fn func(num protowire.Number, value []byte) error) error {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		var value []byte
+		if typ == protowire.BytesType {
+			value, n = protowire.ConsumeBytes(bz)
+		} else {
+			n = protowire.ConsumeFieldValue(num, typ, bz)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		if err := fn(num, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
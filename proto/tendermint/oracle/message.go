@@ -0,0 +1,70 @@
+package oracle
+
+import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// The p2p interfaces implemented by the oracle messages are asserted by the
+// oracle reactor, so that this package can be imported without the p2p layer,
+// e.g. by the oracle/verify package.
+
+// Wrap implements the p2p Wrapper interface and wraps an oracle data request.
+func (m *OracleDataRequest) Wrap() proto.Message {
+	mm := &Message{}
+	mm.Sum = &Message_DataRequest{DataRequest: m}
+	return mm
+}
+
+// Wrap implements the p2p Wrapper interface and wraps an oracle data response.
+func (m *OracleDataResponse) Wrap() proto.Message {
+	mm := &Message{}
+	mm.Sum = &Message_DataResponse{DataResponse: m}
+	return mm
+}
+
+// Wrap implements the p2p Wrapper interface and wraps an oracle ping.
+func (m *OraclePing) Wrap() proto.Message {
+	mm := &Message{}
+	mm.Sum = &Message_Ping{Ping: m}
+	return mm
+}
+
+// Wrap implements the p2p Wrapper interface and wraps an oracle pong.
+func (m *OraclePong) Wrap() proto.Message {
+	mm := &Message{}
+	mm.Sum = &Message_Pong{Pong: m}
+	return mm
+}
+
+// Wrap implements the p2p Wrapper interface and wraps an oracle probe.
+func (m *OracleProbe) Wrap() proto.Message {
+	mm := &Message{}
+	mm.Sum = &Message_Probe{Probe: m}
+	return mm
+}
+
+// Unwrap implements the p2p Wrapper interface and unwraps a wrapped oracle
+// data, ping or probe message.
+func (m *Message) Unwrap() (proto.Message, error) {
+	switch msg := m.Sum.(type) {
+	case *Message_DataRequest:
+		return m.GetDataRequest(), nil
+
+	case *Message_DataResponse:
+		return m.GetDataResponse(), nil
+
+	case *Message_Ping:
+		return m.GetPing(), nil
+
+	case *Message_Pong:
+		return m.GetPong(), nil
+
+	case *Message_Probe:
+		return m.GetProbe(), nil
+
+	default:
+		return nil, fmt.Errorf("unknown message: %T", msg)
+	}
+}
@@ -246,6 +246,9 @@ func (m *DefaultNodeInfo) GetOther() DefaultNodeInfoOther {
 type DefaultNodeInfoOther struct {
 	TxIndex    string `protobuf:"bytes,1,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
 	RPCAddress string `protobuf:"bytes,2,opt,name=rpc_address,json=rpcAddress,proto3" json:"rpc_address,omitempty"`
+	// version of the oracle gossip protocol spoken by the node, empty if the
+	// node does not run the oracle or predates oracle versioning
+	OracleVersion string `protobuf:"bytes,3,opt,name=oracle_version,json=oracleVersion,proto3" json:"oracle_version,omitempty"`
 }
 
 func (m *DefaultNodeInfoOther) Reset()         { *m = DefaultNodeInfoOther{} }
@@ -295,6 +298,13 @@ func (m *DefaultNodeInfoOther) GetRPCAddress() string {
 	return ""
 }
 
+func (m *DefaultNodeInfoOther) GetOracleVersion() string {
+	if m != nil {
+		return m.OracleVersion
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*NetAddress)(nil), "tendermint.p2p.NetAddress")
 	proto.RegisterType((*ProtocolVersion)(nil), "tendermint.p2p.ProtocolVersion")
@@ -305,38 +315,38 @@ func init() {
 func init() { proto.RegisterFile("tendermint/p2p/types.proto", fileDescriptor_c8a29e659aeca578) }
 
 var fileDescriptor_c8a29e659aeca578 = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0x3d, 0x8f, 0xda, 0x40,
-	0x10, 0xc5, 0xc6, 0x7c, 0xdc, 0x10, 0x8e, 0xcb, 0x0a, 0x45, 0x3e, 0x0a, 0x1b, 0xa1, 0x14, 0x54,
-	0xa0, 0x90, 0x2a, 0x5d, 0x42, 0x68, 0x50, 0xa4, 0x8b, 0xb5, 0x8a, 0x52, 0xa4, 0x41, 0xe0, 0x5d,
-	0x60, 0x85, 0xd9, 0x5d, 0xad, 0xf7, 0x12, 0xf2, 0x2f, 0xf2, 0xb3, 0xae, 0xbc, 0x32, 0x95, 0x15,
-	0x99, 0x32, 0x7f, 0x22, 0xf2, 0xae, 0x2f, 0xc7, 0xa1, 0xeb, 0xe6, 0xcd, 0x9b, 0x99, 0x37, 0xf3,
-	0x34, 0xd0, 0xd3, 0x94, 0x13, 0xaa, 0xf6, 0x8c, 0xeb, 0xb1, 0x9c, 0xc8, 0xb1, 0xfe, 0x29, 0x69,
-	0x3a, 0x92, 0x4a, 0x68, 0x81, 0x2e, 0x1f, 0xb9, 0x91, 0x9c, 0xc8, 0x5e, 0x77, 0x23, 0x36, 0xc2,
-	0x50, 0xe3, 0x22, 0xb2, 0x55, 0x83, 0x08, 0xe0, 0x86, 0xea, 0x0f, 0x84, 0x28, 0x9a, 0xa6, 0xe8,
-	0x15, 0xb8, 0x8c, 0xf8, 0x4e, 0xdf, 0x19, 0x5e, 0x4c, 0xeb, 0x79, 0x16, 0xba, 0xf3, 0x19, 0x76,
-	0x19, 0x31, 0x79, 0xe9, 0xbb, 0x27, 0xf9, 0x08, 0xbb, 0x4c, 0x22, 0x04, 0x9e, 0x14, 0x4a, 0xfb,
-	0xd5, 0xbe, 0x33, 0x6c, 0x63, 0x13, 0x0f, 0xbe, 0x40, 0x27, 0x2a, 0x46, 0xc7, 0x22, 0xf9, 0x4a,
-	0x55, 0xca, 0x04, 0x47, 0xd7, 0x50, 0x95, 0x13, 0x69, 0xe6, 0x7a, 0xd3, 0x46, 0x9e, 0x85, 0xd5,
-	0x68, 0x12, 0xe1, 0x22, 0x87, 0xba, 0x50, 0x5b, 0x25, 0x22, 0xde, 0x99, 0xe1, 0x1e, 0xb6, 0x00,
-	0x5d, 0x41, 0x75, 0x29, 0xa5, 0x19, 0xeb, 0xe1, 0x22, 0x1c, 0xfc, 0x75, 0xa1, 0x33, 0xa3, 0xeb,
-	0xe5, 0x6d, 0xa2, 0x6f, 0x04, 0xa1, 0x73, 0xbe, 0x16, 0x28, 0x82, 0x2b, 0x59, 0x2a, 0x2d, 0xbe,
-	0x5b, 0x29, 0xa3, 0xd1, 0x9a, 0x84, 0xa3, 0xa7, 0xc7, 0x8f, 0xce, 0x36, 0x9a, 0x7a, 0x77, 0x59,
-	0x58, 0xc1, 0x1d, 0x79, 0xb6, 0xe8, 0x3b, 0xe8, 0x10, 0x2b, 0xb2, 0xe0, 0x82, 0xd0, 0x05, 0x23,
-	0xe5, 0xd1, 0x2f, 0xf3, 0x2c, 0x6c, 0x9f, 0xea, 0xcf, 0x70, 0x9b, 0x9c, 0x40, 0x82, 0x42, 0x68,
-	0x25, 0x2c, 0xd5, 0x94, 0x2f, 0x96, 0x84, 0x28, 0xb3, 0xfa, 0x05, 0x06, 0x9b, 0x2a, 0xec, 0x45,
-	0x3e, 0x34, 0x38, 0xd5, 0x3f, 0x84, 0xda, 0xf9, 0x9e, 0x21, 0x1f, 0x60, 0xc1, 0x3c, 0xac, 0x5f,
-	0xb3, 0x4c, 0x09, 0x51, 0x0f, 0x9a, 0xf1, 0x76, 0xc9, 0x39, 0x4d, 0x52, 0xbf, 0xde, 0x77, 0x86,
-	0x2f, 0xf0, 0x7f, 0x5c, 0x74, 0xed, 0x05, 0x67, 0x3b, 0xaa, 0xfc, 0x86, 0xed, 0x2a, 0x21, 0x7a,
-	0x0f, 0x35, 0xa1, 0xb7, 0x54, 0xf9, 0x4d, 0x63, 0xc6, 0xeb, 0x73, 0x33, 0xce, 0x7c, 0xfc, 0x5c,
-	0xd4, 0x96, 0x8e, 0xd8, 0xc6, 0xc1, 0x0a, 0xba, 0xcf, 0x15, 0xa1, 0x6b, 0x68, 0xea, 0xc3, 0x82,
-	0x71, 0x42, 0x0f, 0xf6, 0x4b, 0x70, 0x43, 0x1f, 0xe6, 0x05, 0x44, 0x63, 0x68, 0x29, 0x19, 0x9b,
-	0xe3, 0x69, 0x9a, 0x96, 0xb6, 0x5d, 0xe6, 0x59, 0x08, 0x38, 0xfa, 0x58, 0xfe, 0x17, 0x06, 0x25,
-	0xe3, 0x32, 0x9e, 0x7e, 0xba, 0xcb, 0x03, 0xe7, 0x3e, 0x0f, 0x9c, 0x3f, 0x79, 0xe0, 0xfc, 0x3a,
-	0x06, 0x95, 0xfb, 0x63, 0x50, 0xf9, 0x7d, 0x0c, 0x2a, 0xdf, 0xde, 0x6c, 0x98, 0xde, 0xde, 0xae,
-	0x46, 0xb1, 0xd8, 0x8f, 0x63, 0xb1, 0xa7, 0x7a, 0xb5, 0xd6, 0x8f, 0x81, 0x7d, 0xe1, 0xa7, 0x8f,
-	0xbf, 0xaa, 0x9b, 0xec, 0xdb, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0xdb, 0x56, 0x6d, 0x11,
-	0x03, 0x00, 0x00,
+	// 496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4f, 0x8f, 0x12, 0x31,
+	0x14, 0x67, 0x86, 0x59, 0x60, 0x1f, 0x02, 0x6b, 0x43, 0xcc, 0x2c, 0x87, 0x19, 0x42, 0x34, 0xe1,
+	0x04, 0x11, 0x4f, 0xde, 0x14, 0xb9, 0x10, 0x93, 0x75, 0xd2, 0x18, 0x0f, 0x5e, 0x08, 0x4c, 0x0b,
+	0x34, 0x0c, 0x6d, 0xd3, 0xe9, 0x2a, 0x7e, 0x04, 0x6f, 0x7e, 0xac, 0x3d, 0xee, 0xd1, 0x13, 0x31,
+	0xc3, 0xd1, 0x2f, 0x61, 0xa6, 0x1d, 0x76, 0x59, 0xe2, 0xed, 0xfd, 0x7e, 0xef, 0xff, 0xaf, 0xaf,
+	0xd0, 0xd1, 0x94, 0x13, 0xaa, 0xb6, 0x8c, 0xeb, 0xa1, 0x1c, 0xc9, 0xa1, 0xfe, 0x21, 0x69, 0x3a,
+	0x90, 0x4a, 0x68, 0x81, 0x9a, 0x8f, 0xbe, 0x81, 0x1c, 0xc9, 0x4e, 0x7b, 0x25, 0x56, 0xc2, 0xb8,
+	0x86, 0xb9, 0x65, 0xa3, 0x7a, 0x11, 0xc0, 0x0d, 0xd5, 0xef, 0x09, 0x51, 0x34, 0x4d, 0xd1, 0x0b,
+	0x70, 0x19, 0xf1, 0x9d, 0xae, 0xd3, 0xbf, 0x1c, 0x57, 0xb2, 0x7d, 0xe8, 0x4e, 0x27, 0xd8, 0x65,
+	0xc4, 0xf0, 0xd2, 0x77, 0x4f, 0xf8, 0x08, 0xbb, 0x4c, 0x22, 0x04, 0x9e, 0x14, 0x4a, 0xfb, 0xe5,
+	0xae, 0xd3, 0x6f, 0x60, 0x63, 0xf7, 0x3e, 0x43, 0x2b, 0xca, 0x4b, 0xc7, 0x22, 0xf9, 0x42, 0x55,
+	0xca, 0x04, 0x47, 0xd7, 0x50, 0x96, 0x23, 0x69, 0xea, 0x7a, 0xe3, 0x6a, 0xb6, 0x0f, 0xcb, 0xd1,
+	0x28, 0xc2, 0x39, 0x87, 0xda, 0x70, 0xb1, 0x48, 0x44, 0xbc, 0x31, 0xc5, 0x3d, 0x6c, 0x01, 0xba,
+	0x82, 0xf2, 0x5c, 0x4a, 0x53, 0xd6, 0xc3, 0xb9, 0xd9, 0xfb, 0xeb, 0x42, 0x6b, 0x42, 0x97, 0xf3,
+	0xdb, 0x44, 0xdf, 0x08, 0x42, 0xa7, 0x7c, 0x29, 0x50, 0x04, 0x57, 0xb2, 0xe8, 0x34, 0xfb, 0x66,
+	0x5b, 0x99, 0x1e, 0xf5, 0x51, 0x38, 0x78, 0xba, 0xfc, 0xe0, 0x6c, 0xa2, 0xb1, 0x77, 0xb7, 0x0f,
+	0x4b, 0xb8, 0x25, 0xcf, 0x06, 0x7d, 0x0b, 0x2d, 0x62, 0x9b, 0xcc, 0xb8, 0x20, 0x74, 0xc6, 0x48,
+	0xb1, 0xf4, 0xf3, 0x6c, 0x1f, 0x36, 0x4e, 0xfb, 0x4f, 0x70, 0x83, 0x9c, 0x40, 0x82, 0x42, 0xa8,
+	0x27, 0x2c, 0xd5, 0x94, 0xcf, 0xe6, 0x84, 0x28, 0x33, 0xfa, 0x25, 0x06, 0x4b, 0xe5, 0xf2, 0x22,
+	0x1f, 0xaa, 0x9c, 0xea, 0xef, 0x42, 0x6d, 0x7c, 0xcf, 0x38, 0x8f, 0x30, 0xf7, 0x1c, 0xc7, 0xbf,
+	0xb0, 0x9e, 0x02, 0xa2, 0x0e, 0xd4, 0xe2, 0xf5, 0x9c, 0x73, 0x9a, 0xa4, 0x7e, 0xa5, 0xeb, 0xf4,
+	0x9f, 0xe1, 0x07, 0x9c, 0x67, 0x6d, 0x05, 0x67, 0x1b, 0xaa, 0xfc, 0xaa, 0xcd, 0x2a, 0x20, 0x7a,
+	0x07, 0x17, 0x42, 0xaf, 0xa9, 0xf2, 0x6b, 0x46, 0x8c, 0x97, 0xe7, 0x62, 0x9c, 0xe9, 0xf8, 0x29,
+	0x8f, 0x2d, 0x14, 0xb1, 0x89, 0xbd, 0x9f, 0x0e, 0xb4, 0xff, 0x17, 0x85, 0xae, 0xa1, 0xa6, 0x77,
+	0x33, 0xc6, 0x09, 0xdd, 0xd9, 0x33, 0xc1, 0x55, 0xbd, 0x9b, 0xe6, 0x10, 0x0d, 0xa1, 0xae, 0x64,
+	0x6c, 0xb6, 0xa7, 0x69, 0x5a, 0xe8, 0xd6, 0xcc, 0xf6, 0x21, 0xe0, 0xe8, 0x43, 0x71, 0x60, 0x18,
+	0x94, 0x8c, 0x0b, 0x1b, 0xbd, 0x82, 0xa6, 0x50, 0xf3, 0x38, 0xa1, 0x0f, 0x8f, 0x67, 0x45, 0x6b,
+	0x58, 0xf6, 0xf8, 0x54, 0x1f, 0xef, 0xb2, 0xc0, 0xb9, 0xcf, 0x02, 0xe7, 0x4f, 0x16, 0x38, 0xbf,
+	0x0e, 0x41, 0xe9, 0xfe, 0x10, 0x94, 0x7e, 0x1f, 0x82, 0xd2, 0xd7, 0xd7, 0x2b, 0xa6, 0xd7, 0xb7,
+	0x8b, 0x41, 0x2c, 0xb6, 0xc3, 0x58, 0x6c, 0xa9, 0x5e, 0x2c, 0xf5, 0xa3, 0x61, 0x4f, 0xfd, 0xe9,
+	0x07, 0x59, 0x54, 0x0c, 0xfb, 0xe6, 0xdf, 0x00, 0x97, 0x4e, 0x8e, 0x32, 0x39, 0x03, 0x00, 0x00,
 }
 
 func (m *NetAddress) Marshal() (dAtA []byte, err error) {
@@ -524,6 +534,13 @@ func (m *DefaultNodeInfoOther) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OracleVersion) > 0 {
+		i -= len(m.OracleVersion)
+		copy(dAtA[i:], m.OracleVersion)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OracleVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.RPCAddress) > 0 {
 		i -= len(m.RPCAddress)
 		copy(dAtA[i:], m.RPCAddress)
@@ -641,6 +658,10 @@ func (m *DefaultNodeInfoOther) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.OracleVersion)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1293,6 +1314,38 @@ func (m *DefaultNodeInfoOther) Unmarshal(dAtA []byte) error {
 			}
 			m.RPCAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
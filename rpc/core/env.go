@@ -11,6 +11,7 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	mempl "github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/p2p"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
 	"github.com/cometbft/cometbft/proxy"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
@@ -61,6 +62,17 @@ type consensusReactor interface {
 	WaitSync() bool
 }
 
+type oracleReactor interface {
+	PauseSigning() error
+	ResumeSigning() error
+	SigningPaused() bool
+	VoteProof(addr crypto.Address, oracleID string, timestamp int64) (*types.OracleVoteProof, error)
+	GossipedVotes() []*oracleproto.GossipedVotes
+	PeerMisbehavior() []types.OraclePeerMisbehavior
+	PeerLatency() []types.OraclePeerLatency
+	ProbePropagation() []types.OracleProbePropagation
+}
+
 // ----------------------------------------------
 // Environment contains objects and interfaces used by the RPC. It is expected
 // to be setup once during startup.
@@ -77,6 +89,7 @@ type Environment struct {
 	ConsensusReactor consensusReactor
 	P2PPeers         peers
 	P2PTransport     transport
+	OracleReactor    oracleReactor // nil if the oracle is disabled
 
 	// objects
 	PubKey       crypto.PubKey
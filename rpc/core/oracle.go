@@ -0,0 +1,79 @@
+package core
+
+import (
+	"errors"
+
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+// ErrOracleDisabled is returned by the oracle routes when the oracle is
+// disabled.
+var ErrOracleDisabled = errors.New("oracle is disabled")
+
+// UnsafeOraclePause stops the node from signing new batches of oracle votes,
+// while still relaying the batches of other validators.
+func (env *Environment) UnsafeOraclePause(*rpctypes.Context) (*ctypes.ResultOracleSigning, error) {
+	if env.OracleReactor == nil {
+		return nil, ErrOracleDisabled
+	}
+	if err := env.OracleReactor.PauseSigning(); err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultOracleSigning{Paused: env.OracleReactor.SigningPaused()}, nil
+}
+
+// UnsafeOracleResume resumes signing new batches of oracle votes.
+func (env *Environment) UnsafeOracleResume(*rpctypes.Context) (*ctypes.ResultOracleSigning, error) {
+	if env.OracleReactor == nil {
+		return nil, ErrOracleDisabled
+	}
+	if err := env.OracleReactor.ResumeSigning(); err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultOracleSigning{Paused: env.OracleReactor.SigningPaused()}, nil
+}
+
+// OracleVoteProof returns the vote signed by a validator for an oracle at a
+// timestamp, along with a proof that the vote was part of a signed batch of
+// votes, which can be verified with the validator's public key. Only the
+// votes currently gossiped can be proven.
+func (env *Environment) OracleVoteProof(
+	_ *rpctypes.Context,
+	validator []byte,
+	oracleID string,
+	timestamp int64,
+) (*ctypes.ResultOracleVoteProof, error) {
+	if env.OracleReactor == nil {
+		return nil, ErrOracleDisabled
+	}
+	proof, err := env.OracleReactor.VoteProof(validator, oracleID, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultOracleVoteProof{Proof: proof}, nil
+}
+
+// OracleGossipedVotes returns the batches of oracle votes currently gossiped
+// by the node, e.g. to compare them with the batches gossiped by another node.
+func (env *Environment) OracleGossipedVotes(*rpctypes.Context) (*ctypes.ResultOracleGossipedVotes, error) {
+	if env.OracleReactor == nil {
+		return nil, ErrOracleDisabled
+	}
+	return &ctypes.ResultOracleGossipedVotes{Batches: env.OracleReactor.GossipedVotes()}, nil
+}
+
+// OraclePeers returns how often each peer misbehaved on the oracle channels,
+// by reason, and whether we disconnected from it for misbehaving, along with
+// the round trip times of the pings sent to the connected peers, and the time
+// the probes of each node took to propagate to us.
+func (env *Environment) OraclePeers(*rpctypes.Context) (*ctypes.ResultOraclePeers, error) {
+	if env.OracleReactor == nil {
+		return nil, ErrOracleDisabled
+	}
+	return &ctypes.ResultOraclePeers{
+		Misbehavior: env.OracleReactor.PeerMisbehavior(),
+		Latency:     env.OracleReactor.PeerLatency(),
+		Propagation: env.OracleReactor.ProbePropagation(),
+	}, nil
+}
@@ -0,0 +1,138 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestOracleRoutesDisabled(t *testing.T) {
+	// the oracle reactor is not set when Oracle.Enable is false
+	env := &Environment{}
+	ctx := &rpctypes.Context{}
+
+	_, err := env.UnsafeOraclePause(ctx)
+	assert.ErrorIs(t, err, ErrOracleDisabled)
+	_, err = env.UnsafeOracleResume(ctx)
+	assert.ErrorIs(t, err, ErrOracleDisabled)
+	_, err = env.OracleVoteProof(ctx, []byte{0x01}, "oracle", 1)
+	assert.ErrorIs(t, err, ErrOracleDisabled)
+	_, err = env.OracleGossipedVotes(ctx)
+	assert.ErrorIs(t, err, ErrOracleDisabled)
+	_, err = env.OraclePeers(ctx)
+	assert.ErrorIs(t, err, ErrOracleDisabled)
+}
+
+func TestUnsafeOraclePauseResume(t *testing.T) {
+	reactor := &testOracleReactor{}
+	env := &Environment{OracleReactor: reactor}
+	ctx := &rpctypes.Context{}
+
+	res, err := env.UnsafeOraclePause(ctx)
+	require.NoError(t, err)
+	assert.True(t, res.Paused)
+
+	res, err = env.UnsafeOracleResume(ctx)
+	require.NoError(t, err)
+	assert.False(t, res.Paused)
+
+	// relay only oracles do not sign votes
+	reactor.err = errors.New("oracle is relay only and does not sign votes")
+	_, err = env.UnsafeOraclePause(ctx)
+	assert.ErrorIs(t, err, reactor.err)
+	_, err = env.UnsafeOracleResume(ctx)
+	assert.ErrorIs(t, err, reactor.err)
+}
+
+func TestOracleVoteProof(t *testing.T) {
+	proof := &types.OracleVoteProof{}
+	reactor := &testOracleReactor{proofs: map[string]*types.OracleVoteProof{"oracle": proof}}
+	env := &Environment{OracleReactor: reactor}
+
+	res, err := env.OracleVoteProof(&rpctypes.Context{}, []byte{0x01}, "oracle", 1)
+	require.NoError(t, err)
+	assert.Same(t, proof, res.Proof)
+
+	_, err = env.OracleVoteProof(&rpctypes.Context{}, []byte{0x01}, "unknown", 1)
+	assert.Error(t, err)
+}
+
+func TestOracleGossipedVotes(t *testing.T) {
+	batches := []*oracleproto.GossipedVotes{{SignedTimestamp: 1}, {SignedTimestamp: 2}}
+	env := &Environment{OracleReactor: &testOracleReactor{batches: batches}}
+
+	res, err := env.OracleGossipedVotes(&rpctypes.Context{})
+	require.NoError(t, err)
+	assert.Equal(t, batches, res.Batches)
+}
+
+func TestOraclePeers(t *testing.T) {
+	reactor := &testOracleReactor{
+		misbehavior: []types.OraclePeerMisbehavior{{PeerID: "a", Reason: "invalid_signature", Count: 1}},
+		latency:     []types.OraclePeerLatency{{PeerID: "a", Pings: 1, LastRTT: time.Millisecond}},
+		propagation: []types.OracleProbePropagation{{OriginID: "b", Probes: 1, LastHops: 1}},
+	}
+	env := &Environment{OracleReactor: reactor}
+
+	res, err := env.OraclePeers(&rpctypes.Context{})
+	require.NoError(t, err)
+	assert.Equal(t, reactor.misbehavior, res.Misbehavior)
+	assert.Equal(t, reactor.latency, res.Latency)
+	assert.Equal(t, reactor.propagation, res.Propagation)
+}
+
+// testOracleReactor serves the oracle routes from the given values.
+type testOracleReactor struct {
+	// error returned when pausing or resuming signing
+	err         error
+	paused      bool
+	proofs      map[string]*types.OracleVoteProof
+	batches     []*oracleproto.GossipedVotes
+	misbehavior []types.OraclePeerMisbehavior
+	latency     []types.OraclePeerLatency
+	propagation []types.OracleProbePropagation
+}
+
+func (r *testOracleReactor) PauseSigning() error {
+	if r.err != nil {
+		return r.err
+	}
+	r.paused = true
+	return nil
+}
+
+func (r *testOracleReactor) ResumeSigning() error {
+	if r.err != nil {
+		return r.err
+	}
+	r.paused = false
+	return nil
+}
+
+func (r *testOracleReactor) SigningPaused() bool { return r.paused }
+
+func (r *testOracleReactor) VoteProof(_ crypto.Address, oracleID string, _ int64) (*types.OracleVoteProof, error) {
+	proof, ok := r.proofs[oracleID]
+	if !ok {
+		return nil, errors.New("no vote")
+	}
+	return proof, nil
+}
+
+func (r *testOracleReactor) GossipedVotes() []*oracleproto.GossipedVotes { return r.batches }
+
+func (r *testOracleReactor) PeerMisbehavior() []types.OraclePeerMisbehavior { return r.misbehavior }
+
+func (r *testOracleReactor) PeerLatency() []types.OraclePeerLatency { return r.latency }
+
+func (r *testOracleReactor) ProbePropagation() []types.OracleProbePropagation {
+	return r.propagation
+}
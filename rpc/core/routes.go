@@ -51,6 +51,11 @@ func (env *Environment) GetRoutes() RoutesMap {
 
 		// evidence API
 		"broadcast_evidence": rpc.NewRPCFunc(env.BroadcastEvidence, "evidence"),
+
+		// oracle API
+		"oracle_vote_proof":     rpc.NewRPCFunc(env.OracleVoteProof, "validator,oracle_id,timestamp"),
+		"oracle_gossiped_votes": rpc.NewRPCFunc(env.OracleGossipedVotes, ""),
+		"oracle_peers":          rpc.NewRPCFunc(env.OraclePeers, ""),
 	}
 }
 
@@ -60,4 +65,8 @@ func (env *Environment) AddUnsafeRoutes(routes RoutesMap) {
 	routes["dial_seeds"] = rpc.NewRPCFunc(env.UnsafeDialSeeds, "seeds")
 	routes["dial_peers"] = rpc.NewRPCFunc(env.UnsafeDialPeers, "peers,persistent,unconditional,private")
 	routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(env.UnsafeFlushMempool, "")
+
+	// oracle API
+	routes["oracle_pause"] = rpc.NewRPCFunc(env.UnsafeOraclePause, "")
+	routes["oracle_resume"] = rpc.NewRPCFunc(env.UnsafeOracleResume, "")
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/cometbft/cometbft/crypto"
 	"github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cometbft/cometbft/p2p"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/types"
 )
@@ -239,6 +240,28 @@ type ResultBroadcastEvidence struct {
 	Hash []byte `json:"hash"`
 }
 
+// Result of pausing or resuming oracle signing
+type ResultOracleSigning struct {
+	Paused bool `json:"paused"`
+}
+
+// Result of proving that a validator signed an oracle vote
+type ResultOracleVoteProof struct {
+	Proof *types.OracleVoteProof `json:"proof"`
+}
+
+// Batches of oracle votes gossiped by the node
+type ResultOracleGossipedVotes struct {
+	Batches []*oracleproto.GossipedVotes `json:"batches"`
+}
+
+// Misbehavior and round trip times of peers on the oracle channels
+type ResultOraclePeers struct {
+	Misbehavior []types.OraclePeerMisbehavior  `json:"misbehavior"`
+	Latency     []types.OraclePeerLatency      `json:"latency"`
+	Propagation []types.OracleProbePropagation `json:"propagation"`
+}
+
 // empty results
 type (
 	ResultUnsafeFlushMempool struct{}
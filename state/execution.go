@@ -11,13 +11,13 @@ import (
 	"github.com/cometbft/cometbft/libs/fail"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/mempool"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/proxy"
 	"github.com/cometbft/cometbft/types"
 	"github.com/sirupsen/logrus"
 
 	oracletypes "github.com/cometbft/cometbft/oracle/service/types"
-	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
 )
 
 //-----------------------------------------------------------------------------
@@ -167,19 +167,23 @@ func (blockExec *BlockExecutor) CreateProposalBlock(
 	// inject oracleTx containing gossipedVotes from vals, this is ran after PrepareProposal, so that CreateOracleResultTx
 	// hook will use the updated context from prepareProposalState
 
-	// check if oracle's gossipVoteMap has any results
-	preLockTime := time.Now().UnixMilli()
-	blockExec.oracleInfo.GossipVoteBuffer.RLock()
-	oracleVotesBuffer := blockExec.oracleInfo.GossipVoteBuffer.Buffer
-	votes := []*oracleproto.GossipedVotes{}
-	for _, vote := range oracleVotesBuffer {
-		votes = append(votes, vote)
-	}
-	blockExec.oracleInfo.GossipVoteBuffer.RUnlock()
-	postLockTime := time.Now().UnixMilli()
-	diff := postLockTime - preLockTime
-	if diff > 100 {
-		logrus.Warnf("WARNING!!! Injecting oracle tx gossip lock took %v milliseconds", diff)
+	// check if oracle's gossipVoteMap has any results, the oracle is disabled if there is no oracleInfo,
+	// or it has no state, as for block executors only replaying blocks
+	var votes []*oracleproto.GossipedVotes
+	if blockExec.oracleInfo != nil && blockExec.oracleInfo.State != nil {
+		preLockTime := time.Now().UnixMilli()
+		// batches with votes whose data was not received yet are left out, so that the app does
+		// not aggregate votes without their data
+		filled, missing := blockExec.oracleInfo.VoteDataStore.FillCompleteData(blockExec.oracleInfo.State.CurrentBatches())
+		if missing > 0 {
+			blockExec.logger.Info("Leaving out oracle batches with missing vote data", "batches", missing)
+		}
+		votes = oracletypes.WithoutUnsignedMetadata(filled)
+		postLockTime := time.Now().UnixMilli()
+		diff := postLockTime - preLockTime
+		if diff > 100 {
+			logrus.Warnf("WARNING!!! Injecting oracle tx gossip lock took %v milliseconds", diff)
+		}
 	}
 
 	var createOracleResultTxBz []byte
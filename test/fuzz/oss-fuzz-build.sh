@@ -21,3 +21,7 @@ build_go_fuzzer FuzzP2PSecretConnection fuzz_p2p_secretconnection
 build_go_fuzzer FuzzMempool fuzz_mempool
 
 build_go_fuzzer FuzzRPCJSONRPCServer fuzz_rpc_jsonrpc_server
+
+build_go_fuzzer FuzzOracleGossipedVotes fuzz_oracle_gossiped_votes
+
+build_go_fuzzer FuzzOracleMessage fuzz_oracle_message
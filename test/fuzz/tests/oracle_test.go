@@ -0,0 +1,70 @@
+//go:build gofuzz || go1.21
+
+package tests
+
+import (
+	"bytes"
+	"testing"
+
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+)
+
+func FuzzOracleGossipedVotes(f *testing.F) {
+	batch := &oracleproto.GossipedVotes{
+		PubKey:          []byte{0x01},
+		Votes:           []*oracleproto.Vote{{OracleId: "oracle", Timestamp: 1, Data: "1"}},
+		SignedTimestamp: 1,
+		Signature:       []byte{0x02},
+		RelayHops:       []*oracleproto.RelayHop{{NodeId: "node", ReceivedAt: 1}},
+	}
+	bz, err := batch.Marshal()
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(bz)
+	// regression: empty votes only take 2 bytes each, so a message within the
+	// channel capacity could otherwise allocate tens of thousands of votes
+	f.Add(bytes.Repeat([]byte{0x12, 0x00}, oracleproto.MaxVotesPerGossipedVotes+1))
+	f.Add(bytes.Repeat([]byte{0x32, 0x00}, oracleproto.MaxRelayHopsPerGossipedVotes+1))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		msg := &oracleproto.GossipedVotes{}
+		if err := msg.ValidateEncoding(data); err != nil {
+			return
+		}
+		if err := msg.Unmarshal(data); err != nil {
+			return
+		}
+		if len(msg.Votes) > oracleproto.MaxVotesPerGossipedVotes {
+			t.Fatalf("decoded %d votes", len(msg.Votes))
+		}
+		if len(msg.RelayHops) > oracleproto.MaxRelayHopsPerGossipedVotes {
+			t.Fatalf("decoded %d relay hops", len(msg.RelayHops))
+		}
+	})
+}
+
+func FuzzOracleMessage(f *testing.F) {
+	request := (&oracleproto.OracleDataRequest{DataHashes: [][]byte{{0x01}}}).Wrap().(*oracleproto.Message)
+	bz, err := request.Marshal()
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(bz)
+	// regression: a data request with more data hashes than allowed
+	hashes := bytes.Repeat([]byte{0x0a, 0x00}, oracleproto.MaxDataHashesPerRequest+1)
+	f.Add(append([]byte{0x0a, 0xa2, 0x9c, 0x01}, hashes...))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		msg := &oracleproto.Message{}
+		if err := msg.ValidateEncoding(data); err != nil {
+			return
+		}
+		if err := msg.Unmarshal(data); err != nil {
+			return
+		}
+		if request := msg.GetDataRequest(); request != nil && len(request.DataHashes) > oracleproto.MaxDataHashesPerRequest {
+			t.Fatalf("decoded %d data hashes", len(request.DataHashes))
+		}
+	})
+}
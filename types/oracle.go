@@ -1,25 +1,156 @@
 package types
 
 import (
-	"github.com/cometbft/cometbft/libs/protoio"
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/oracle/verify"
 	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
 )
 
 func OracleVoteSignBytes(chainID string, vote *oracleproto.GossipedVotes) []byte {
-	pb := CanonicalizeOracleVote(chainID, vote)
-	bz, err := protoio.MarshalDelimited(&pb)
-	if err != nil {
-		panic(err)
-	}
-
-	return bz
+	return verify.SignBytes(chainID, vote)
 }
 
 func CanonicalizeOracleVote(chainID string, vote *oracleproto.GossipedVotes) oracleproto.CanonicalGossipedVotes {
 	return oracleproto.CanonicalGossipedVotes{
 		PubKey:          vote.PubKey,
-		Votes:           vote.Votes,
 		SignedTimestamp: vote.SignedTimestamp,
 		ChainId:         chainID,
+		BatchSeq:        vote.BatchSeq,
+		VotesHash:       OracleVotesHash(vote.Votes),
+	}
+}
+
+// OracleVotesHash returns the merkle root of the votes in a batch. The leaves
+// of the tree are the proto encoded votes, in the order they appear in the
+// batch.
+func OracleVotesHash(votes []*oracleproto.Vote) []byte {
+	return verify.VotesHash(votes)
+}
+
+// OracleVoteDataHash returns the hash of the vote data, used in place of the
+// data for votes whose data is too large to be gossiped inline.
+func OracleVoteDataHash(data string) []byte {
+	return verify.DataHash(data)
+}
+
+// ValidateOracleVoteData returns an error if the vote is gossiped by data
+// hash and carries data not matching the hash.
+func ValidateOracleVoteData(vote *oracleproto.Vote) error {
+	return verify.ValidateVoteData(vote)
+}
+
+// OracleVoteProof proves that a single vote was part of a signed batch of
+// oracle votes, without requiring the rest of the batch.
+type OracleVoteProof struct {
+	PubKey          cmtbytes.HexBytes `json:"pub_key"`
+	SignedTimestamp int64             `json:"signed_timestamp"`
+	BatchSeq        uint32            `json:"batch_seq"`
+	Signature       cmtbytes.HexBytes `json:"signature"`
+	VotesHash       cmtbytes.HexBytes `json:"votes_hash"`
+	Vote            *oracleproto.Vote `json:"vote"`
+	Proof           merkle.Proof      `json:"proof"`
+}
+
+// NewOracleVoteProof returns a proof for the i'th vote of the signed batch.
+func NewOracleVoteProof(batch *oracleproto.GossipedVotes, i int) (*OracleVoteProof, error) {
+	if i < 0 || i >= len(batch.Votes) {
+		return nil, fmt.Errorf("vote index %d out of range, batch has %d votes", i, len(batch.Votes))
+	}
+
+	root, proofs := merkle.ProofsFromByteSlices(verify.VoteLeaves(batch.Votes))
+	return &OracleVoteProof{
+		PubKey:          batch.PubKey,
+		SignedTimestamp: batch.SignedTimestamp,
+		BatchSeq:        batch.BatchSeq,
+		Signature:       batch.Signature,
+		VotesHash:       root,
+		Vote:            batch.Votes[i],
+		Proof:           *proofs[i],
+	}, nil
+}
+
+// SignBytes returns the bytes signed by the batch the vote was part of.
+func (p *OracleVoteProof) SignBytes(chainID string) []byte {
+	return verify.CanonicalSignBytes(&oracleproto.CanonicalGossipedVotes{
+		PubKey:          p.PubKey,
+		SignedTimestamp: p.SignedTimestamp,
+		ChainId:         chainID,
+		BatchSeq:        p.BatchSeq,
+		VotesHash:       p.VotesHash,
+	})
+}
+
+// Verify checks that the vote is included in the votes hash and that the
+// batch signature over the votes hash is valid for the given public key.
+func (p *OracleVoteProof) Verify(chainID string, pubKey crypto.PubKey) error {
+	if p.Vote == nil {
+		return errors.New("proof has no vote")
+	}
+	if !bytes.Equal(pubKey.Bytes(), p.PubKey) {
+		return errors.New("public key does not match the batch public key")
+	}
+	if p.Proof.Index < 0 {
+		return errors.New("proof index cannot be negative")
+	}
+	if p.Proof.Total <= 0 {
+		return errors.New("proof total must be positive")
+	}
+	if err := ValidateOracleVoteData(p.Vote); err != nil {
+		return err
+	}
+	if err := p.Proof.Verify(p.VotesHash, verify.VoteLeaf(p.Vote)); err != nil {
+		return fmt.Errorf("vote is not included in the votes hash: %w", err)
 	}
+	if len(p.Signature) < verify.SignaturePrefixSize {
+		return errors.New("signature is too short")
+	}
+	if !pubKey.VerifySignature(p.SignBytes(chainID), p.Signature[verify.SignaturePrefixSize:]) {
+		return errors.New("invalid batch signature")
+	}
+	return nil
+}
+
+// OraclePeerMisbehavior reports how often a peer misbehaved on the oracle
+// channels for a given reason, e.g. by relaying batches of votes with an
+// invalid signature.
+type OraclePeerMisbehavior struct {
+	PeerID    string    `json:"peer_id"`
+	Reason    string    `json:"reason"`
+	Count     int       `json:"count"`
+	FirstSeen time.Time `json:"first_seen"`
+	LastSeen  time.Time `json:"last_seen"`
+	// whether we disconnected from the peer for this misbehavior
+	Stopped bool `json:"stopped"`
+}
+
+// OraclePeerLatency summarizes the round trip times of the pings sent to a
+// peer on the oracle channels.
+type OraclePeerLatency struct {
+	PeerID   string        `json:"peer_id"`
+	Pings    int           `json:"pings"`
+	LastRTT  time.Duration `json:"last_rtt"`
+	MinRTT   time.Duration `json:"min_rtt"`
+	MaxRTT   time.Duration `json:"max_rtt"`
+	LastSeen time.Time     `json:"last_seen"`
+}
+
+// OracleProbePropagation summarizes how long the probes sent by a node took
+// to propagate to us across the oracle mesh. The times are measured against
+// the clock of the origin, so they include the skew between the clocks.
+type OracleProbePropagation struct {
+	OriginID    string        `json:"origin_id"`
+	Probes      int           `json:"probes"`
+	LastLatency time.Duration `json:"last_latency"`
+	MinLatency  time.Duration `json:"min_latency"`
+	MaxLatency  time.Duration `json:"max_latency"`
+	// number of nodes that relayed the last probe
+	LastHops uint32    `json:"last_hops"`
+	LastSeen time.Time `json:"last_seen"`
 }
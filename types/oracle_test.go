@@ -0,0 +1,173 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/oracle/verify"
+	oracleproto "github.com/cometbft/cometbft/proto/tendermint/oracle"
+)
+
+func makeSignedOracleBatch(t *testing.T, pv MockPV, chainID string, n int) *oracleproto.GossipedVotes {
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+
+	batch := &oracleproto.GossipedVotes{
+		PubKey:          pubKey.Bytes(),
+		SignedTimestamp: 1700000000,
+		BatchSeq:        1,
+	}
+	for i := 0; i < n; i++ {
+		batch.Votes = append(batch.Votes, &oracleproto.Vote{
+			Validator: pubKey.Address().String(),
+			OracleId:  "oracle-" + strconv.Itoa(i),
+			Timestamp: 1700000000,
+			Data:      strconv.Itoa(i * 100),
+		})
+	}
+	require.NoError(t, pv.SignOracleVote(chainID, batch, []byte{0, 0}))
+	return batch
+}
+
+func TestOracleVoteProof(t *testing.T) {
+	const chainID = "test_chain_id"
+	pv := NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+
+	batch := makeSignedOracleBatch(t, pv, chainID, 7)
+	for i := range batch.Votes {
+		proof, err := NewOracleVoteProof(batch, i)
+		require.NoError(t, err)
+		assert.EqualValues(t, OracleVotesHash(batch.Votes), proof.VotesHash)
+		assert.NoError(t, proof.Verify(chainID, pubKey), "vote %d", i)
+	}
+
+	_, err = NewOracleVoteProof(batch, len(batch.Votes))
+	assert.Error(t, err)
+
+	proof, err := NewOracleVoteProof(batch, 3)
+	require.NoError(t, err)
+
+	// wrong chain
+	assert.Error(t, proof.Verify("other_chain_id", pubKey))
+
+	// wrong signer
+	otherPubKey, err := NewMockPV().GetPubKey()
+	require.NoError(t, err)
+	assert.Error(t, proof.Verify(chainID, otherPubKey))
+
+	// tampered vote
+	tampered := *proof
+	tampered.Vote = &oracleproto.Vote{
+		Validator: proof.Vote.Validator,
+		OracleId:  proof.Vote.OracleId,
+		Timestamp: proof.Vote.Timestamp,
+		Data:      "1",
+	}
+	assert.Error(t, tampered.Verify(chainID, pubKey))
+
+	// votes hash not covered by the signature
+	tampered = *proof
+	tampered.VotesHash = OracleVotesHash(batch.Votes[:1])
+	assert.Error(t, tampered.Verify(chainID, pubKey))
+}
+
+// makeRepresentativeOracleBatch returns a signed batch of n votes resembling
+// the price votes gossiped on mainnet.
+func makeRepresentativeOracleBatch(n int) *oracleproto.GossipedVotes {
+	pubKey := ed25519.GenPrivKeyFromSecret([]byte("oracle")).PubKey()
+	batch := &oracleproto.GossipedVotes{
+		PubKey:          pubKey.Bytes(),
+		SignedTimestamp: 1700000000,
+		BatchSeq:        1,
+		Signature:       make([]byte, verify.SignaturePrefixSize+ed25519.SignatureSize),
+	}
+	for i := 0; i < n; i++ {
+		batch.Votes = append(batch.Votes, &oracleproto.Vote{
+			Validator: pubKey.Address().String(),
+			OracleId:  fmt.Sprintf("a1b2c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2e3f4a5b6c7d8e9f0%04d", i),
+			Timestamp: 1700000000 + int64(i),
+			Data:      fmt.Sprintf("%d.%08d", 40000+i, i*12345),
+		})
+	}
+	return batch
+}
+
+// TestOracleGossipedVotesSize guards the wire size of gossiped batches, which
+// determines the oracle gossip bandwidth. If a change intentionally grows the
+// size, update the expected sizes.
+func TestOracleGossipedVotesSize(t *testing.T) {
+	// fail if the size grows by more than this fraction
+	const threshold = 0.05
+
+	testCases := []struct {
+		numVotes int
+		wantSize int
+	}{
+		{10, 1442},
+		{100, 13412},
+		{1000, 133112},
+	}
+
+	for _, tc := range testCases {
+		t.Run(strconv.Itoa(tc.numVotes), func(t *testing.T) {
+			batch := makeRepresentativeOracleBatch(tc.numVotes)
+			size := batch.Size()
+			t.Logf("%d votes: %d bytes", tc.numVotes, size)
+			assert.LessOrEqual(t, float64(size), float64(tc.wantSize)*(1+threshold),
+				"gossiped batch of %d votes is %d bytes, expected at most %d bytes", tc.numVotes, size, tc.wantSize)
+		})
+	}
+}
+
+func BenchmarkOracleGossipedVotesMarshal(b *testing.B) {
+	for _, n := range []int{10, 100, 1000} {
+		batch := makeRepresentativeOracleBatch(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(batch.Size()))
+			for i := 0; i < b.N; i++ {
+				if _, err := batch.Marshal(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkOracleGossipedVotesUnmarshal(b *testing.B) {
+	for _, n := range []int{10, 100, 1000} {
+		bz, err := makeRepresentativeOracleBatch(n).Marshal()
+		if err != nil {
+			b.Fatal(err)
+		}
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(bz)))
+			for i := 0; i < b.N; i++ {
+				var batch oracleproto.GossipedVotes
+				if err := batch.Unmarshal(bz); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkOracleVoteSignBytes(b *testing.B) {
+	for _, n := range []int{10, 100, 1000} {
+		batch := makeRepresentativeOracleBatch(n)
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				OracleVoteSignBytes("test_chain_id", batch)
+			}
+		})
+	}
+}